@@ -2,20 +2,27 @@ package account
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
+
+	"example.com/dummyheaad/tmdbCLI/client"
 )
 
 var (
 	ErrConnection      = errors.New("connection error")
-	ErrNotFound        = errors.New("not found")
-	ErrInvalidResponse = errors.New("invalid server response")
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
 	ErrInvalid         = errors.New("invalid data")
 	ErrNotNumber       = errors.New("not a number")
 )
 
+var (
+	sendRequest        = client.SendRequest
+	sendRequestContext = client.SendRequestContext
+)
+
 type gravatar struct {
 	Hash string `json:"hash"`
 }
@@ -39,16 +46,14 @@ type DetailsResponse struct {
 	Username     string `json:"username"`
 }
 
-func newClient() *http.Client {
-	c := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-	return c
+func GetDetails(url string) (*DetailsResponse, error) {
+	return GetDetailsContext(context.Background(), url)
 }
 
-func GetDetails(url string) (*DetailsResponse, error) {
+// GetDetailsContext is GetDetails, the request being made with ctx
+func GetDetailsContext(ctx context.Context, url string) (*DetailsResponse, error) {
 
-	respByte, err := sendRequest(url, http.MethodGet, "", http.StatusOK, nil)
+	respByte, err := sendRequestContext(ctx, url, http.MethodGet, "", http.StatusOK, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -60,3 +65,8 @@ func GetDetails(url string) (*DetailsResponse, error) {
 
 	return resp, nil
 }
+
+// ResolveImages replaces the avatar path with the URL returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.Avatar.Tmdb.AvatarPath = url("profile", r.Avatar.Tmdb.AvatarPath)
+}
@@ -2,11 +2,10 @@ package account
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
 )
 
 type AddFavoriteResponse struct {
@@ -63,47 +62,22 @@ type FavoriteTvResponse struct {
 	TotalResults int            `json:"total_results"`
 }
 
-func sendRequest(url, method, contentType string,
-	expStatus int, body io.Reader) ([]byte, error) {
-
-	authToken := os.Getenv("AUTH_TOKEN")
-
-	req, err := http.NewRequest(method, url, body)
-	req.Header.Add("accept", "application/json")
-	req.Header.Add("Authorization", "Bearer "+authToken)
-	if err != nil {
-		return nil, err
-	}
-
-	if contentType != "" {
-		req.Header.Set("Content-Type", contentType)
-	}
-
-	r, err := newClient().Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Body.Close()
-
-	if r.StatusCode != expStatus {
-		msg, err := io.ReadAll(r.Body)
-		if err != nil {
-			return nil, fmt.Errorf("cannot read body: %w", err)
-		}
-		err = ErrInvalidResponse
-		if r.StatusCode == http.StatusNotFound {
-			err = ErrNotFound
-		}
-
-		return nil, fmt.Errorf("%w: %s", err, msg)
+// DisplayTitle returns the title in the requested language, or the original
+// title when TMDB has no translation
+func (r favMovieResults) DisplayTitle() string {
+	if r.Title != "" {
+		return r.Title
 	}
+	return r.OriginalTitle
+}
 
-	resp, err := io.ReadAll(r.Body)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read body: %w", err)
+// DisplayName returns the name in the requested language, or the original
+// name when TMDB has no translation
+func (r favTvResults) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
 	}
-
-	return resp, nil
+	return r.OriginalName
 }
 
 func AddFavorite(url, mediaType string, mediaID int, favorite bool) (*AddFavoriteResponse, error) {
@@ -144,20 +118,39 @@ func AddFavorite(url, mediaType string, mediaID int, favorite bool) (*AddFavorit
 	return resp, nil
 }
 
-func GetFavorite[T *FavoriteMoviesResponse | *FavoriteTvResponse](url, mediaType string) (T, error) {
-	var resp T
+// GetFavorite fetches every page of the favorite movies or TV shows
+func GetFavorite[T interface {
+	*FavoriteMoviesResponse | *FavoriteTvResponse
+	paged[T]
+}](url, mediaType, language string) (T, error) {
+	u := fmt.Sprintf("%s/favorite/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
 
-	// TODO: handle query params
-	u := fmt.Sprintf("%s/favorite/%s?language=en-US&page=1&sort_by=created_at.asc", url, mediaType)
+	return getPages[T](context.Background(), u)
+}
 
-	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
-	if err != nil {
-		return nil, err
-	}
+// FavoritePages passes the pages of the favorite movies or TV shows to fn
+// as they are received, see eachPage
+func FavoritePages[T interface {
+	*FavoriteMoviesResponse | *FavoriteTvResponse
+	paged[T]
+}](url, mediaType, language string, fn func(page T) error) error {
+	u := fmt.Sprintf("%s/favorite/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
 
-	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
-		return nil, err
+	return eachPage(context.Background(), u, fn)
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *FavoriteMoviesResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
 	}
+}
 
-	return resp, nil
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *FavoriteTvResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
+	}
 }
@@ -41,3 +41,12 @@ func GetLists(url string, page int) (*ListsResponse, error) {
 
 	return resp, nil
 }
+
+// ResolveImages replaces the poster paths with the URLs returned by url
+func (r *ListsResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		if p, ok := r.Results[i].PosterPath.(string); ok {
+			r.Results[i].PosterPath = url("poster", p)
+		}
+	}
+}
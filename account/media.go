@@ -0,0 +1,136 @@
+package account
+
+// Media is the common view of the movies and TV shows of the favorite,
+// watchlist and rated lists, of the rated episodes and of the custom lists
+// items
+type Media struct {
+	ID          int
+	Title       string
+	Date        string
+	GenreIds    []int
+	Overview    string
+	PosterPath  string
+	Popularity  float64
+	VoteAverage float64
+	VoteCount   int
+	Rating      float64
+}
+
+// The results of the favorite, watchlist and rated lists, named for the
+// code handling them alike
+type (
+	FavoriteMovie  = favMovieResults
+	FavoriteTv     = favTvResults
+	WatchlistMovie = watchlistMoviesResults
+	WatchlistTv    = watchlistTvResults
+	RatedMovie     = ratedMoviesResults
+	RatedTv        = ratedTvResults
+	RatedEpisode   = ratedTvEpisodeResults
+)
+
+// Media returns the common view of the movie
+func (r favMovieResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayTitle(),
+		Date:        r.ReleaseDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+	}
+}
+
+// Media returns the common view of the TV show
+func (r favTvResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayName(),
+		Date:        r.FirstAirDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+	}
+}
+
+// Media returns the common view of the movie
+func (r watchlistMoviesResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayTitle(),
+		Date:        r.ReleaseDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+	}
+}
+
+// Media returns the common view of the TV show
+func (r watchlistTvResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayName(),
+		Date:        r.FirstAirDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+	}
+}
+
+// Media returns the common view of the movie, along with its rating
+func (r ratedMoviesResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayTitle(),
+		Date:        r.ReleaseDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+		Rating:      r.Rating,
+	}
+}
+
+// Media returns the common view of the TV show, along with its rating
+func (r ratedTvResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.DisplayName(),
+		Date:        r.FirstAirDate,
+		GenreIds:    r.GenreIds,
+		Overview:    r.Overview,
+		PosterPath:  r.PosterPath,
+		Popularity:  r.Popularity,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+		Rating:      r.Rating,
+	}
+}
+
+// Media returns the common view of the TV episode, along with its rating.
+// Episodes have no genres nor popularity, their still stands for the poster
+func (r ratedTvEpisodeResults) Media() Media {
+	return Media{
+		ID:          r.ID,
+		Title:       r.Name,
+		Date:        r.AirDate,
+		Overview:    r.Overview,
+		PosterPath:  r.StillPath,
+		VoteAverage: r.VoteAverage,
+		VoteCount:   r.VoteCount,
+		Rating:      r.Rating,
+	}
+}
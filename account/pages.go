@@ -0,0 +1,77 @@
+package account
+
+import (
+	"context"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+// paged is implemented by the responses holding a page of results
+type paged[T any] interface {
+	*FavoriteMoviesResponse | *FavoriteTvResponse |
+		*WatchlistMoviesResponse | *WatchlistTvResponse |
+		*RatedMoviesResponse | *RatedTvResponse | *RatedTvEpisodeResponse
+	pageCount() int
+	addPage(page T)
+}
+
+// eachPage fetches the pages of the results at u one after the other and
+// passes them to fn as soon as they are received, see client.EachPage
+func eachPage[T paged[T]](ctx context.Context, u string, fn func(page T) error) error {
+	return client.EachPage(ctx, u, func(page T) int { return page.pageCount() }, fn)
+}
+
+// getPages fetches every page of the results at u and returns them merged
+// into the first one
+func getPages[T paged[T]](ctx context.Context, u string) (T, error) {
+	var resp T
+
+	err := eachPage(ctx, u, func(page T) error {
+		if resp == nil {
+			resp = page
+		} else {
+			resp.addPage(page)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *FavoriteMoviesResponse) pageCount() int { return r.TotalPages }
+func (r *FavoriteMoviesResponse) addPage(page *FavoriteMoviesResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *FavoriteTvResponse) pageCount() int { return r.TotalPages }
+func (r *FavoriteTvResponse) addPage(page *FavoriteTvResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *WatchlistMoviesResponse) pageCount() int { return r.TotalPages }
+func (r *WatchlistMoviesResponse) addPage(page *WatchlistMoviesResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *WatchlistTvResponse) pageCount() int { return r.TotalPages }
+func (r *WatchlistTvResponse) addPage(page *WatchlistTvResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *RatedMoviesResponse) pageCount() int { return r.TotalPages }
+func (r *RatedMoviesResponse) addPage(page *RatedMoviesResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *RatedTvResponse) pageCount() int { return r.TotalPages }
+func (r *RatedTvResponse) addPage(page *RatedTvResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
+
+func (r *RatedTvEpisodeResponse) pageCount() int { return r.TotalPages }
+func (r *RatedTvEpisodeResponse) addPage(page *RatedTvEpisodeResponse) {
+	r.Results = append(r.Results, page.Results...)
+}
@@ -2,11 +2,19 @@ package account
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 )
 
+type AddRatingResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+}
+
 type ratedMoviesResults struct {
 	Adult            bool    `json:"adult"`
 	BackdropPath     string  `json:"backdrop_path"`
@@ -57,6 +65,24 @@ type RatedTvResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+// DisplayTitle returns the title in the requested language, or the original
+// title when TMDB has no translation
+func (r ratedMoviesResults) DisplayTitle() string {
+	if r.Title != "" {
+		return r.Title
+	}
+	return r.OriginalTitle
+}
+
+// DisplayName returns the name in the requested language, or the original
+// name when TMDB has no translation
+func (r ratedTvResults) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.OriginalName
+}
+
 type ratedTvEpisodeResults struct {
 	AirDate        string  `json:"air_date"`
 	EpisodeNumber  int     `json:"episode_number"`
@@ -81,38 +107,131 @@ type RatedTvEpisodeResponse struct {
 	TotalResults int                     `json:"total_results"`
 }
 
-func GetRatedEpisodes(url string) (*RatedTvEpisodeResponse, error) {
+// GetRatedEpisodes fetches every page of the rated TV episodes
+func GetRatedEpisodes(url, language string) (*RatedTvEpisodeResponse, error) {
+	u := fmt.Sprintf("%s/rated/tv/episodes?language=%s&sort_by=created_at.asc", url, language)
+
+	return getPages[*RatedTvEpisodeResponse](context.Background(), u)
+}
 
-	// TODO: handle query params
-	u := fmt.Sprintf("%s/rated/tv/episodes?language=en-US&page=1&sort_by=created_at.asc", url)
+// RatedEpisodesPages passes the pages of the rated TV episodes to fn as
+// they are received, see eachPage
+func RatedEpisodesPages(url, language string, fn func(page *RatedTvEpisodeResponse) error) error {
+	u := fmt.Sprintf("%s/rated/tv/episodes?language=%s&sort_by=created_at.asc", url, language)
 
-	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
-	if err != nil {
+	return eachPage(context.Background(), u, fn)
+}
+
+// GetRatedShow fetches every page of the rated movies or TV shows
+func GetRatedShow[T interface {
+	*RatedMoviesResponse | *RatedTvResponse
+	paged[T]
+}](url, mediaType, language string) (T, error) {
+	return GetRatedShowContext[T](context.Background(), url, mediaType, language)
+}
+
+// GetRatedShowContext is GetRatedShow, the requests being made with ctx
+func GetRatedShowContext[T interface {
+	*RatedMoviesResponse | *RatedTvResponse
+	paged[T]
+}](ctx context.Context, url, mediaType, language string) (T, error) {
+	u := fmt.Sprintf("%s/rated/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
+
+	return getPages[T](ctx, u)
+}
+
+// RatedShowPages passes the pages of the rated movies or TV shows to fn
+// as they are received, see eachPage
+func RatedShowPages[T interface {
+	*RatedMoviesResponse | *RatedTvResponse
+	paged[T]
+}](url, mediaType, language string, fn func(page T) error) error {
+	u := fmt.Sprintf("%s/rated/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
+
+	return eachPage(context.Background(), u, fn)
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *RatedMoviesResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
+	}
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *RatedTvResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
+	}
+}
+
+// ResolveImages replaces the still paths with the URLs returned by url
+func (r *RatedTvEpisodeResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].StillPath = url("still", r.Results[i].StillPath)
+	}
+}
+
+// ValidateRating checks that value is between 0.5 and 10.0 in 0.5 steps
+func ValidateRating(value float64) error {
+	if value < 0.5 || value > 10 || math.Mod(value*2, 1) != 0 {
+		return fmt.Errorf("%w: rating must be between 0.5 and 10.0 in 0.5 steps, got %v", ErrInvalid, value)
+	}
+	return nil
+}
+
+// AddRating rates the movie, TV show or episode at url
+func AddRating(url string, value float64) (*AddRatingResponse, error) {
+	if err := ValidateRating(value); err != nil {
 		return nil, err
 	}
 
-	var resp *RatedTvEpisodeResponse
-	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+	u := fmt.Sprintf("%s/rating", url)
+
+	rating := struct {
+		Value float64 `json:"value"`
+	}{
+		Value: value,
+	}
+
+	var body bytes.Buffer
+
+	if err := json.NewEncoder(&body).Encode(rating); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	respByte, err := sendRequest(u, http.MethodPost, "application/json", http.StatusCreated, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeRatingResponse(respByte)
 }
 
-func GetRatedShow[T *RatedMoviesResponse | *RatedTvResponse](url, mediaType string) (T, error) {
-	var resp T
+// DeleteRating removes the rating of the movie, TV show or episode at url
+func DeleteRating(url string) (*AddRatingResponse, error) {
 
-	// TODO: handle query params
-	u := fmt.Sprintf("%s/rated/%s?language=en-US&page=1&sort_by=created_at.asc", url, mediaType)
+	u := fmt.Sprintf("%s/rating", url)
 
-	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	respByte, err := sendRequest(u, http.MethodDelete, "", http.StatusOK, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return decodeRatingResponse(respByte)
+}
+
+func decodeRatingResponse(respByte []byte) (*AddRatingResponse, error) {
+	var resp *AddRatingResponse
 	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
 		return nil, err
 	}
 
+	if !resp.Success {
+		return resp, fmt.Errorf("%w: %s", ErrInvalidResponse, resp.StatusMessage)
+	}
+
 	return resp, nil
 }
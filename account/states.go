@@ -0,0 +1,68 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// StateRating is the rating found in the account states. TMDB sends false
+// when the title isn't rated and {"value": x} otherwise
+type StateRating struct {
+	Rated bool
+	Value float64
+}
+
+func (r *StateRating) UnmarshalJSON(data []byte) error {
+	if string(data) == "false" || string(data) == "null" {
+		*r = StateRating{}
+		return nil
+	}
+
+	var v struct {
+		Value float64 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("%w: rated: %s", ErrInvalid, data)
+	}
+
+	*r = StateRating{Rated: true, Value: v.Value}
+	return nil
+}
+
+func (r StateRating) MarshalJSON() ([]byte, error) {
+	if !r.Rated {
+		return []byte("false"), nil
+	}
+
+	return json.Marshal(struct {
+		Value float64 `json:"value"`
+	}{r.Value})
+}
+
+type StatesResponse struct {
+	ID        int         `json:"id"`
+	Favorite  bool        `json:"favorite"`
+	Rated     StateRating `json:"rated"`
+	Watchlist bool        `json:"watchlist"`
+}
+
+// GetStates fetches whether the movie, TV show or episode at url is a
+// favorite, on the watchlist or rated. Episodes only carry a rating
+func GetStates(url string) (*StatesResponse, error) {
+
+	u := fmt.Sprintf("%s/account_states", url)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *StatesResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
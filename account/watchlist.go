@@ -2,6 +2,7 @@ package account
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -61,6 +62,24 @@ type WatchlistTvResponse struct {
 	TotalResults int                  `json:"total_results"`
 }
 
+// DisplayTitle returns the title in the requested language, or the original
+// title when TMDB has no translation
+func (r watchlistMoviesResults) DisplayTitle() string {
+	if r.Title != "" {
+		return r.Title
+	}
+	return r.OriginalTitle
+}
+
+// DisplayName returns the name in the requested language, or the original
+// name when TMDB has no translation
+func (r watchlistTvResults) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.OriginalName
+}
+
 func AddWatchlist(url, mediaType string, mediaID int, watchlist bool) (*AddWatchlistResponse, error) {
 
 	u := fmt.Sprintf("%s/watchlist", url)
@@ -99,20 +118,39 @@ func AddWatchlist(url, mediaType string, mediaID int, watchlist bool) (*AddWatch
 	return resp, nil
 }
 
-func GetWatchlist[T *WatchlistMoviesResponse | *WatchlistTvResponse](url, mediaType string) (T, error) {
-	var resp T
+// GetWatchlist fetches every page of the watchlist movies or TV shows
+func GetWatchlist[T interface {
+	*WatchlistMoviesResponse | *WatchlistTvResponse
+	paged[T]
+}](url, mediaType, language string) (T, error) {
+	u := fmt.Sprintf("%s/watchlist/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
 
-	// TODO: handle query params
-	u := fmt.Sprintf("%s/watchlist/%s?language=en-US&page=1&sort_by=created_at.asc", url, mediaType)
+	return getPages[T](context.Background(), u)
+}
 
-	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
-	if err != nil {
-		return nil, err
-	}
+// WatchlistPages passes the pages of the movies or TV shows of the
+// watchlist to fn as they are received, see eachPage
+func WatchlistPages[T interface {
+	*WatchlistMoviesResponse | *WatchlistTvResponse
+	paged[T]
+}](url, mediaType, language string, fn func(page T) error) error {
+	u := fmt.Sprintf("%s/watchlist/%s?language=%s&sort_by=created_at.asc", url, mediaType, language)
 
-	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
-		return nil, err
+	return eachPage(context.Background(), u, fn)
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *WatchlistMoviesResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
 	}
+}
 
-	return resp, nil
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *WatchlistTvResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
+	}
 }
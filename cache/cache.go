@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Dir returns the directory holding the cached TMDB data. It defaults to
+// tmdbCLI under the user cache directory and can be overridden with the
+// TMDB_CACHE_DIR environment variable
+func Dir() (string, error) {
+	if dir := os.Getenv("TMDB_CACHE_DIR"); dir != "" {
+		return dir, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "tmdbCLI"), nil
+}
+
+// Key builds a file name safe cache key out of parts
+func Key(parts ...string) string {
+	clean := func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}
+
+	for i, p := range parts {
+		parts[i] = strings.Map(clean, p)
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// bypass makes Get miss, see Bypass
+var bypass bool
+
+// Bypass makes the lookups miss while on, the data being fetched again from
+// TMDB, e.g. to print the responses as the server sent them. The data are
+// still stored
+func Bypass(on bool) {
+	bypass = on
+}
+
+// Get returns the data stored under key if it is younger than maxAge
+func Get(key string, maxAge time.Duration) ([]byte, bool) {
+	if bypass {
+		return nil, false
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return nil, false
+	}
+
+	path := filepath.Join(dir, key+".json")
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > maxAge {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// Put stores data under key, creating the cache directory when needed
+func Put(key string, data []byte) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, key+".json"), data, 0o644)
+}
+
+// Remove deletes every cached entry whose key starts with prefix
+func Remove(prefix string) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, prefix+"*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, m := range matches {
+		if err := os.Remove(m); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package certification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrUnknownCertification = errors.New("unknown certification")
+)
+
+var sendRequest = client.SendRequestContext
+
+// cacheAge is how long a downloaded certification list is reused
+const cacheAge = 7 * 24 * time.Hour
+
+type Certification struct {
+	Certification string `json:"certification"`
+	Meaning       string `json:"meaning"`
+	Order         int    `json:"order"`
+}
+
+type ListResponse struct {
+	Certifications map[string][]Certification `json:"certifications"`
+}
+
+// GetList fetches the official certifications of every region for movies
+// or TV shows
+func GetList(ctx context.Context, url string) (*ListResponse, error) {
+
+	respByte, err := sendRequest(ctx, url, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *ListResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Load returns the certifications for mediaType (movie or tv), using the
+// local cache when it is fresh enough
+func Load(ctx context.Context, apiRoot, mediaType string) (*ListResponse, error) {
+	key := cache.Key("certification", mediaType, apiRoot)
+
+	var resp *ListResponse
+
+	if data, ok := cache.Get(key, cacheAge); ok {
+		if err := json.Unmarshal(data, &resp); err != nil {
+			resp = nil
+		}
+	}
+
+	if resp == nil {
+		var err error
+		url := fmt.Sprintf("%s/certification/%s/list", apiRoot, mediaType)
+		if resp, err = GetList(ctx, url); err != nil {
+			return nil, err
+		}
+
+		if len(resp.Certifications) > 0 {
+			data, err := json.Marshal(resp)
+			if err != nil {
+				return nil, err
+			}
+			// A broken cache only costs another request, don't fail on it
+			_ = cache.Put(key, data)
+		}
+	}
+
+	return resp, nil
+}
+
+// Region returns the certifications of region from the least to the most
+// restrictive
+func (r *ListResponse) Region(region string) []Certification {
+	var certs []Certification
+	for k, v := range r.Certifications {
+		if strings.EqualFold(k, region) {
+			certs = append(certs, v...)
+		}
+	}
+	sort.SliceStable(certs, func(i, j int) bool {
+		return certs[i].Order < certs[j].Order
+	})
+	return certs
+}
+
+// Order returns the rank of cert in region, higher is more restrictive
+func (r *ListResponse) Order(region, cert string) (int, error) {
+	for _, c := range r.Region(region) {
+		if strings.EqualFold(c.Certification, cert) {
+			return c.Order, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q in %s", ErrUnknownCertification, cert, region)
+}
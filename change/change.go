@@ -0,0 +1,122 @@
+package change
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+// MaxRange is the longest period TMDB returns the changes of at once
+const MaxRange = 14 * 24 * time.Hour
+
+type changeItem struct {
+	ID            string          `json:"id"`
+	Action        string          `json:"action"`
+	Time          string          `json:"time"`
+	ISO_639_1     string          `json:"iso_639_1,omitempty"`
+	ISO_3166_1    string          `json:"iso_3166_1,omitempty"`
+	Value         json.RawMessage `json:"value,omitempty"`
+	OriginalValue json.RawMessage `json:"original_value,omitempty"`
+}
+
+type keyChanges struct {
+	Key   string       `json:"key"`
+	Items []changeItem `json:"items"`
+}
+
+type Response struct {
+	Changes []keyChanges `json:"changes"`
+}
+
+// Get fetches the changes made to the movie, TV show or person at url
+// between start and end, both YYYY-MM-DD and at most MaxRange apart
+func Get(url, start, end string) (*Response, error) {
+
+	u := fmt.Sprintf("%s/changes?start_date=%s&end_date=%s&page=1", url, start, end)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetRange fetches the changes made between start and end, splitting the
+// period into windows TMDB accepts. The items are merged by key, oldest
+// first
+func GetRange(url string, start, end time.Time) (*Response, error) {
+	byKey := make(map[string]*keyChanges)
+	var keys []string
+
+	for from := start; !from.After(end); from = from.Add(MaxRange) {
+		to := from.Add(MaxRange - 24*time.Hour)
+		if to.After(end) {
+			to = end
+		}
+
+		resp, err := Get(url, from.Format(time.DateOnly), to.Format(time.DateOnly))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range resp.Changes {
+			k, ok := byKey[c.Key]
+			if !ok {
+				k = &keyChanges{Key: c.Key}
+				byKey[c.Key] = k
+				keys = append(keys, c.Key)
+			}
+			k.Items = append(k.Items, c.Items...)
+		}
+	}
+
+	sort.Strings(keys)
+
+	resp := &Response{Changes: make([]keyChanges, 0, len(keys))}
+	for _, key := range keys {
+		k := byKey[key]
+		sort.SliceStable(k.Items, func(i, j int) bool {
+			return k.Items[i].Time < k.Items[j].Time
+		})
+		resp.Changes = append(resp.Changes, *k)
+	}
+
+	return resp, nil
+}
+
+// Filter keeps the changes of the given keys, e.g. images or overview. An
+// empty keys list keeps everything
+func (r *Response) Filter(keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	changes := r.Changes[:0]
+	for _, c := range r.Changes {
+		for _, k := range keys {
+			if c.Key == k {
+				changes = append(changes, c)
+				break
+			}
+		}
+	}
+	r.Changes = changes
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidResponse = errors.New("invalid server response")
+)
+
+// Response is a response of the TMDB API as the server sent it
+type Response struct {
+	Method string
+	URL    string
+	Proto  string
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+var (
+	mu        sync.Mutex
+	recording bool
+	responses []Response
+)
+
+// Record starts keeping the successful responses received, dropping the
+// ones kept so far. They are returned by Recorded
+func Record() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	recording = true
+	responses = nil
+}
+
+// Recorded returns the responses received since Record, in the order of
+// their requests
+func Recorded() []Response {
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The places of the failed requests stay empty
+	var recorded []Response
+	for _, r := range responses {
+		if r.Status != 0 {
+			recorded = append(recorded, r)
+		}
+	}
+	return recorded
+}
+
+// lookupKey marks the contexts of the side lookups, see Lookup
+type lookupKey struct{}
+
+// Lookup returns a context for the requests made along the way by a
+// command, e.g. to name the genres or find the account region. Their
+// responses aren't recorded, Recorded only returning the ones of the
+// command itself
+func Lookup(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lookupKey{}, true)
+}
+
+// reserve keeps the place of the response to a request about to be sent,
+// so that the responses are recorded in the order of their requests even
+// when they are sent concurrently. It returns -1 when not recording
+func reserve() int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if !recording {
+		return -1
+	}
+	responses = append(responses, Response{})
+	return len(responses) - 1
+}
+
+func record(i int, r Response) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if recording && i >= 0 && i < len(responses) {
+		responses[i] = r
+	}
+}
+
+func newClient() *http.Client {
+	c := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	return c
+}
+
+// SendRequest performs an authenticated request against the TMDB API and
+// returns the response body when the server replies with expStatus
+func SendRequest(url, method, contentType string,
+	expStatus int, body io.Reader) ([]byte, error) {
+	return SendRequestContext(context.Background(), url, method, contentType, expStatus, body)
+}
+
+// SendRequestContext is SendRequest, the request being aborted when ctx is
+// canceled
+func SendRequestContext(ctx context.Context, url, method, contentType string,
+	expStatus int, body io.Reader) ([]byte, error) {
+
+	authToken := os.Getenv("AUTH_TOKEN")
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("Authorization", "Bearer "+authToken)
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	slot := -1
+	if ctx.Value(lookupKey{}) == nil {
+		slot = reserve()
+	}
+
+	r, err := newClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != expStatus {
+		msg, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read body: %w", err)
+		}
+		err = ErrInvalidResponse
+		if r.StatusCode == http.StatusNotFound {
+			err = ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w: %s", err, msg)
+	}
+
+	resp, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read body: %w", err)
+	}
+
+	if slot >= 0 {
+		record(slot, Response{
+			Method: method,
+			URL:    url,
+			Proto:  r.Proto,
+			Status: r.StatusCode,
+			Header: r.Header,
+			Body:   resp,
+		})
+	}
+
+	return resp, nil
+}
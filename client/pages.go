@@ -0,0 +1,72 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// maxPages is the last page TMDB serves
+const maxPages = 500
+
+// EachPage fetches the pages of the results at u, a URL having a query,
+// one after the other and passes them to fn as soon as they are received,
+// pageCount returning the number of pages a page tells. The next page is
+// fetched while fn runs. An error of fn stops the walk, canceling the
+// request of the next page
+func EachPage[T any](ctx context.Context, u string, pageCount func(page T) int, fn func(page T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		page T
+		err  error
+	}
+
+	fetch := func(n int) <-chan result {
+		c := make(chan result, 1)
+		go func() {
+			page, err := getPage[T](ctx, u, n)
+			c <- result{page, err}
+		}()
+		return c
+	}
+
+	next := fetch(1)
+	for n := 1; ; n++ {
+		r := <-next
+		if r.err != nil {
+			return r.err
+		}
+
+		last := n >= pageCount(r.page) || n >= maxPages
+		if !last {
+			next = fetch(n + 1)
+		}
+
+		if err := fn(r.page); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// getPage fetches page n of the results at u
+func getPage[T any](ctx context.Context, u string, n int) (T, error) {
+	var page T
+
+	respByte, err := SendRequestContext(ctx, fmt.Sprintf("%s&page=%d", u, n), http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return page, err
+	}
+
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&page); err != nil {
+		return page, err
+	}
+
+	return page, nil
+}
@@ -6,32 +6,284 @@ Check LICENSE for details.
 package cmd
 
 import (
-	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
-	"text/tabwriter"
+	"os"
+	"strings"
+	"text/template"
 
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/client"
+	"example.com/dummyheaad/tmdbCLI/configuration"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/output"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // accountCmd represents the account command
 var accountCmd = &cobra.Command{
-	Use:          "account",
-	Short:        "TMDB API for account",
+	Use:   "account",
+	Short: "TMDB API for account",
+	Long: `TMDB API for account.
+
+The favorite, watchlist and rated lists are printed page after page as they
+are received in the text, jsonl, csv and tsv formats, unless --sort-by needs
+them all first.`,
 	SilenceUsage: true,
 }
 
 // TODO: implement integration test on account API
 
-func printResp(out io.Writer, resp any) error {
-	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
-	// Print using type conversion interface{} into struct
-	s, err := json.MarshalIndent(resp, "", "\t")
+// render prints resp in the --output format. The commands print the text
+// format themselves when they have a human readable output, render prints
+// it as json otherwise, e.g. for the mutations
+func render(out io.Writer, opts outputOptions, resp any) error {
+	if opts.query != nil {
+		return renderQuery(out, opts, resp)
+	}
+	if opts.template != nil {
+		return opts.template.Execute(out, resp)
+	}
+	if opts.raw {
+		return renderRaw(out, opts)
+	}
+	return output.Render(out, opts.outputFormat(), resp, opts.renderOptions())
+}
+
+// parseTemplate parses the --template value, adding to the output helpers
+// the image function giving the URL of an image path in the --image-size
+// or the given size, e.g. {{image "poster" .PosterPath "w185"}}
+func parseTemplate(apiRoot, imageSize, text string) (*template.Template, error) {
+	image := func(kind, path string, size ...string) (string, error) {
+		cfg, err := configuration.Load(lookupCtx, apiRoot)
+		if err != nil {
+			return "", err
+		}
+
+		// The paths may have been resolved already, keep the file only
+		base := strings.TrimSuffix(cfg.Images.SecureBaseURL, "/") + "/"
+		if rest, ok := strings.CutPrefix(path, base); ok {
+			if _, file, ok := strings.Cut(rest, "/"); ok {
+				path = "/" + file
+			}
+		}
+
+		s := imageSize
+		if len(size) > 0 {
+			s = size[0]
+		}
+		return cfg.ImageURL(kind, path, s)
+	}
+
+	return output.ParseTemplate(text, template.FuncMap{"image": image})
+}
+
+// outputOptions holds the settings shared by the commands printing results
+type outputOptions struct {
+	format      string
+	template    *template.Template
+	query       *output.Query
+	querySource string
+	language    string
+	imageSize   string
+	genres      []string
+	columns     []string
+	sortBy      string
+	terminal    bool
+	width       int
+	wrap        bool
+	color       bool
+	theme       output.Theme
+	locale      *i18n.Locale
+	// raw prints the TMDB responses as received, see renderRaw
+	raw            bool
+	pretty         bool
+	includeHeaders bool
+	mergePages     bool
+}
+
+// outputFormat returns the --output format, text by default
+func (o outputOptions) outputFormat() string {
+	if o.format == "" {
+		return output.Text
+	}
+	return o.format
+}
+
+// renderOptions returns the settings of the output renderers
+func (o outputOptions) renderOptions() output.Options {
+	return output.Options{Width: o.width, Wrap: o.wrap, Color: o.color, Theme: o.theme}
+}
+
+// human tells whether the results are printed in the text format, a
+// --query or a --template taking precedence over --output
+func (o outputOptions) human() bool {
+	return o.query == nil && o.template == nil && o.outputFormat() == output.Text
+}
+
+func getOutputOptions(cmd *cobra.Command) (outputOptions, error) {
+	var (
+		opts outputOptions
+		err  error
+	)
+
+	opts.format = viper.GetString("output")
+	if err := output.Valid(opts.format); err != nil {
+		return opts, err
+	}
+
+	// --raw prints the TMDB responses as received, in place of the json
+	// output of the results
+	if cmd.Flags().Lookup("raw") != nil {
+		if opts.raw, err = cmd.Flags().GetBool("raw"); err != nil {
+			return opts, err
+		}
+	}
+	opts.pretty = viper.GetBool("pretty")
+	opts.includeHeaders = viper.GetBool("include-headers")
+	opts.mergePages = viper.GetBool("merge-pages")
+	cache.Bypass(opts.raw)
+	if opts.raw {
+		opts.format = "json"
+		client.Record()
+	} else if opts.pretty || opts.includeHeaders || opts.mergePages {
+		return opts, errors.New("--pretty, --include-headers and --merge-pages only apply to --raw")
+	}
+	if cmd.Flags().Lookup("genre") != nil {
+		if opts.genres, err = cmd.Flags().GetStringSlice("genre"); err != nil {
+			return opts, err
+		}
+	}
+
+	// The list commands have --columns along with --sort-by, which other
+	// commands may define with a meaning of their own
+	if cmd.Flags().Lookup("columns") != nil {
+		if opts.columns, err = cmd.Flags().GetStringSlice("columns"); err != nil {
+			return opts, err
+		}
+		if opts.sortBy, err = cmd.Flags().GetString("sort-by"); err != nil {
+			return opts, err
+		}
+
+		// The watchlist also sorts the movies on their regional release,
+		// which is up to its releaseOptions
+		if cmd.Flags().Lookup("release-type") != nil && releaseSort(opts.sortBy) {
+			opts.sortBy = ""
+		}
+
+		if err := validateColumns(opts.columns, opts.sortBy); err != nil {
+			return opts, err
+		}
+	}
+	opts.language = viper.GetString("language")
+	if opts.locale, err = i18n.Select(viper.GetString("ui-language")); err != nil {
+		return opts, err
+	}
+	opts.imageSize = viper.GetString("image-size")
+
+	// The tables only fit in the terminal width when printed to it
+	if opts.terminal = isTerminal(os.Stdout); opts.terminal {
+		opts.width = terminalWidth(os.Stdout)
+	}
+	opts.wrap = viper.GetBool("wrap")
+
+	// NO_COLOR only turns off the automatic colors, see https://no-color.org
+	switch viper.GetString("color") {
+	case "auto":
+		opts.color = opts.terminal && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"
+	case "always":
+		opts.color = true
+	case "never":
+	default:
+		return opts, errors.New("invalid --color value, use auto, always or never")
+	}
+	if opts.theme, err = output.ParseTheme(viper.GetString("theme")); err != nil {
+		return opts, err
+	}
+
+	if text := viper.GetString("template"); text != "" {
+		opts.template, err = parseTemplate(viper.GetString("api-root"), opts.imageSize, text)
+		if err != nil {
+			return opts, err
+		}
+	}
+
+	if expr := viper.GetString("query"); expr != "" {
+		if opts.template != nil {
+			return opts, errors.New("--query and --template can't be used together")
+		}
+		if opts.query, err = output.ParseQuery(expr); err != nil {
+			return opts, err
+		}
+
+		opts.querySource = viper.GetString("query-source")
+		switch opts.querySource {
+		case "typed":
+		case "server":
+			client.Record()
+		default:
+			return opts, errors.New("invalid --query-source value, use typed or server")
+		}
+	}
+
+	return opts, nil
+}
+
+// loadGenres returns the genre names for mediaType (movies or tv) and a
+// function matching the --genre filter. The registry is only fetched when
+// it is needed, i.e. for human readable output or when filtering
+func loadGenres(apiRoot, mediaType string, opts outputOptions) (genre.Names, func([]int) bool, error) {
+	match := func([]int) bool { return true }
+
+	if !opts.human() && len(opts.genres) == 0 && !opts.needsGenres() {
+		return nil, match, nil
+	}
+
+	if mediaType == "movies" {
+		mediaType = "movie"
+	}
+
+	names, err := genre.Load(lookupCtx, apiRoot, mediaType, opts.language)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(opts.genres) > 0 {
+		if match, err = names.Matcher(opts.genres); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return names, match, nil
+}
+
+// imageResolver is implemented by the responses carrying image paths
+type imageResolver interface {
+	ResolveImages(url func(kind, path string) string)
+}
+
+// resolveImages turns the relative image paths of resp into absolute URLs
+// of the requested size, based on the cached API configuration
+func resolveImages(apiRoot string, opts outputOptions, resp imageResolver) error {
+	cfg, err := configuration.Load(lookupCtx, apiRoot)
 	if err != nil {
 		return err
 	}
-	fmt.Fprintf(w, "%s", string(s))
-	return w.Flush()
+
+	// Sizes differ between image kinds, h632 only exists for profiles, so
+	// the size is checked for each image and the first failure is returned
+	var resolveErr error
+	resp.ResolveImages(func(kind, path string) string {
+		u, err := cfg.ImageURL(kind, path, opts.imageSize)
+		if err != nil && resolveErr == nil {
+			resolveErr = err
+		}
+		return u
+	})
+
+	return resolveErr
 }
 
 func init() {
@@ -2,11 +2,38 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/certification"
+	"example.com/dummyheaad/tmdbCLI/client"
+	"example.com/dummyheaad/tmdbCLI/collection"
+	"example.com/dummyheaad/tmdbCLI/configuration"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/images"
+	"example.com/dummyheaad/tmdbCLI/list"
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"example.com/dummyheaad/tmdbCLI/output"
+	"example.com/dummyheaad/tmdbCLI/person"
+	"example.com/dummyheaad/tmdbCLI/review"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func TestDetailsAction(t *testing.T) {
@@ -20,23 +47,22 @@ func TestDetailsAction(t *testing.T) {
 			Body   string
 		}
 		closeServer bool
-		isRaw       bool
+		format      string
 	}{
 		{
 			name:      "Details",
 			accountID: "null",
 			expError:  nil,
-			expOut:    "Account details for 21907685\nID: 21907685\nUsername: clairvoyance27\n",
+			expOut:    "Account details for 21907685\nID: 21907685\nUsername: clairvoyance27\nAvatar: https://image.tmdb.org/t/p/original/yUaRo4KmeADP7lkAS0t9p7r36yQ.jpg\n",
 			resp:      testResp["resultsDetails"],
-			isRaw:     false,
 		},
 		{
 			name:      "DetailsRaw",
 			accountID: "null",
 			expError:  nil,
-			expOut:    "{\n   \"avatar\": {\n      \"gravatar\": {\n         \"hash\": \"5a33321a08977fbf047ab4d39105637a\"\n      },\n      \"tmdb\": {\n         \"avatar_path\": \"/yUaRo4KmeADP7lkAS0t9p7r36yQ.jpg\"\n      }\n   },\n   \"id\": 21907685,\n   \"iso_639_1\": \"en\",\n   \"iso_3166_1\": \"ID\",\n   \"name\": \"Uka\",\n   \"include_adult\": false,\n   \"username\": \"clairvoyance27\"\n}",
+			expOut:    "{\n   \"avatar\": {\n      \"gravatar\": {\n         \"hash\": \"5a33321a08977fbf047ab4d39105637a\"\n      },\n      \"tmdb\": {\n         \"avatar_path\": \"https://image.tmdb.org/t/p/original/yUaRo4KmeADP7lkAS0t9p7r36yQ.jpg\"\n      }\n   },\n   \"id\": 21907685,\n   \"iso_639_1\": \"en\",\n   \"iso_3166_1\": \"ID\",\n   \"name\": \"Uka\",\n   \"include_adult\": false,\n   \"username\": \"clairvoyance27\"\n}",
 			resp:      testResp["resultsDetails"],
-			isRaw:     true,
+			format:    "json",
 		},
 	}
 
@@ -44,6 +70,9 @@ func TestDetailsAction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintln(w, tc.resp.Body)
 				})
@@ -55,7 +84,7 @@ func TestDetailsAction(t *testing.T) {
 
 			var out bytes.Buffer
 
-			err := detailsAction(&out, url, tc.accountID, tc.isRaw)
+			err := detailsAction(&out, url, tc.accountID, outputOptions{format: tc.format})
 
 			if tc.expError != nil {
 				if err == nil {
@@ -91,7 +120,7 @@ func TestListsAction(t *testing.T) {
 			Body   string
 		}
 		closeServer bool
-		isRaw       bool
+		format      string
 	}{
 		{
 			name:     "Lists",
@@ -99,7 +128,6 @@ func TestListsAction(t *testing.T) {
 			expError: nil,
 			expOut:   "Lists:\n1. Name: my-list-2\nDescription: test my list 2\nList Type: movie\nTotal Items: 2\n\n2. Name: my-list\nDescription: test my list\nList Type: movie\nTotal Items: 2\n\n",
 			resp:     testResp["resultsLists"],
-			isRaw:    false,
 		},
 		{
 			name:     "NoLists",
@@ -107,7 +135,6 @@ func TestListsAction(t *testing.T) {
 			expError: nil,
 			expOut:   "Lists:\n",
 			resp:     testResp["resultsNoLists"],
-			isRaw:    false,
 		},
 		{
 			name:     "ListsRaw",
@@ -115,7 +142,7 @@ func TestListsAction(t *testing.T) {
 			expError: nil,
 			expOut:   "{\n   \"page\": 1,\n   \"results\": [\n      {\n         \"description\": \"test my list 2\",\n         \"favorite_count\": 0,\n         \"id\": 8525470,\n         \"item_count\": 2,\n         \"iso_639_1\": \"en\",\n         \"list_type\": \"movie\",\n         \"name\": \"my-list-2\",\n         \"poster_path\": null\n      },\n      {\n         \"description\": \"test my list\",\n         \"favorite_count\": 0,\n         \"id\": 8521773,\n         \"item_count\": 2,\n         \"iso_639_1\": \"en\",\n         \"list_type\": \"movie\",\n         \"name\": \"my-list\",\n         \"poster_path\": null\n      }\n   ],\n   \"total_pages\": 1,\n   \"total_results\": 2\n}",
 			resp:     testResp["resultsLists"],
-			isRaw:    true,
+			format:   "json",
 		},
 	}
 
@@ -123,6 +150,9 @@ func TestListsAction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintln(w, tc.resp.Body)
 				})
@@ -134,7 +164,7 @@ func TestListsAction(t *testing.T) {
 
 			var out bytes.Buffer
 
-			err := listsAction(&out, url, tc.page, tc.isRaw)
+			err := listsAction(&out, url, tc.page, outputOptions{format: tc.format})
 
 			if tc.expError != nil {
 				if err == nil {
@@ -161,12 +191,14 @@ func TestListsAction(t *testing.T) {
 
 func TestGetFavoriteAction(t *testing.T) {
 	testCases := []struct {
-		name     string
-		args     []string
-		isRaw    bool
-		expError error
-		expOut   string
-		resp     struct {
+		name      string
+		args      []string
+		genres    []string
+		imageSize string
+		format    string
+		expError  error
+		expOut    string
+		resp      struct {
 			Status int
 			Body   string
 		}
@@ -175,25 +207,57 @@ func TestGetFavoriteAction(t *testing.T) {
 		{
 			name:     "FavMovies",
 			args:     []string{"movies"},
-			isRaw:    false,
 			expError: nil,
-			expOut:   "Favorite Movies:\n1. Title: Cosmic Chaos\nRelease Date: 2023-08-03\nPopularity: 160.04\nVote Count: 46\nVote Average: 6.00\n\n2. Title: Absolut\nRelease Date: 2005-04-20\nPopularity: 0.29\nVote Count: 29\nVote Average: 7.80\n\n",
+			expOut:   "Favorite Movies:\n1. Title: Cosmic Chaos\nRelease Date: 2023-08-03\nGenres: Thriller, Science Fiction\nPoster: https://image.tmdb.org/t/p/original/mClzWv7gBqgXfjZXp49Enyoex1v.jpg\nPopularity: 160.04\nVote Count: 46\nVote Average: 6.00\n\n2. Title: Absolut\nRelease Date: 2005-04-20\nGenres: Thriller\nPoster: https://image.tmdb.org/t/p/original/17tI2vsEoMZFnzfkg5RCrtcG59s.jpg\nPopularity: 0.29\nVote Count: 29\nVote Average: 7.80\n\n",
 			resp:     testResp["resultsFavMovies"],
 		},
 		{
 			name:     "FavTv",
 			args:     []string{"tv"},
-			isRaw:    false,
 			expError: nil,
-			expOut:   "Favorite TV Shows:\n1. Name: Till Death Us Do Part\nFirst Air Date: 1966-06-06\nPopularity: 12.82\nVote Count: 24\nVote Average: 7.40\n\n2. Name: Game of Thrones\nFirst Air Date: 2011-04-17\nPopularity: 192.15\nVote Count: 24838\nVote Average: 8.46\n\n",
+			expOut:   "Favorite TV Shows:\n1. Name: Till Death Us Do Part\nFirst Air Date: 1966-06-06\nGenres: Comedy\nPoster: https://image.tmdb.org/t/p/original/5r8enLaWs3SnVoInZYsOLZgboki.jpg\nPopularity: 12.82\nVote Count: 24\nVote Average: 7.40\n\n2. Name: Game of Thrones\nFirst Air Date: 2011-04-17\nGenres: Sci-Fi & Fantasy, Drama, Action & Adventure\nPoster: https://image.tmdb.org/t/p/original/1XS1oqL89opfnbLl8WnZY1O1uJx.jpg\nPopularity: 192.15\nVote Count: 24838\nVote Average: 8.46\n\n",
+			resp:     testResp["resultsFavTv"],
+		},
+		{
+			name:     "FavMoviesByGenre",
+			args:     []string{"movies"},
+			genres:   []string{"science fiction", "THRILLER"},
+			expError: nil,
+			expOut:   "Favorite Movies:\n1. Title: Cosmic Chaos\nRelease Date: 2023-08-03\nGenres: Thriller, Science Fiction\nPoster: https://image.tmdb.org/t/p/original/mClzWv7gBqgXfjZXp49Enyoex1v.jpg\nPopularity: 160.04\nVote Count: 46\nVote Average: 6.00\n\n",
+			resp:     testResp["resultsFavMovies"],
+		},
+		{
+			name:     "UnknownGenre",
+			args:     []string{"tv"},
+			genres:   []string{"Space Opera"},
+			expError: genre.ErrUnknownGenre,
 			resp:     testResp["resultsFavTv"],
 		},
+		{
+			name:      "FavMoviesImageSize",
+			args:      []string{"movies"},
+			genres:    []string{"science fiction"},
+			imageSize: "w400",
+			expError:  nil,
+			expOut:    "Favorite Movies:\n1. Title: Cosmic Chaos\nRelease Date: 2023-08-03\nGenres: Thriller, Science Fiction\nPoster: https://image.tmdb.org/t/p/w500/mClzWv7gBqgXfjZXp49Enyoex1v.jpg\nPopularity: 160.04\nVote Count: 46\nVote Average: 6.00\n\n",
+			resp:      testResp["resultsFavMovies"],
+		},
+		{
+			name:      "InvalidImageSize",
+			args:      []string{"movies"},
+			imageSize: "huge",
+			expError:  configuration.ErrInvalidSize,
+			resp:      testResp["resultsFavMovies"],
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintln(w, tc.resp.Body)
 				})
@@ -205,7 +269,8 @@ func TestGetFavoriteAction(t *testing.T) {
 
 			var out bytes.Buffer
 
-			err := getAction(&out, url, tc.args, tc.isRaw)
+			opts := outputOptions{format: tc.format, language: "en-US", imageSize: tc.imageSize, genres: tc.genres}
+			err := getAction(&out, url, tc.args, opts)
 
 			if tc.expError != nil {
 				if err == nil {
@@ -270,7 +335,7 @@ func TestAddFavoriteAction(t *testing.T) {
 
 	var out bytes.Buffer
 
-	if err := addAction(&out, url, args); err != nil {
+	if err := addAction(&out, url, args, outputOptions{}); err != nil {
 		t.Fatalf("Expected no error, got %q", err)
 	}
 
@@ -283,39 +348,50 @@ func TestGetWatchlistAction(t *testing.T) {
 	testCases := []struct {
 		name     string
 		args     []string
+		genres   []string
 		expError error
 		expOut   string
 		resp     struct {
 			Status int
 			Body   string
 		}
-		isRaw       bool
+		format      string
 		closeServer bool
 	}{
 		{
 			name:        "WatchlistMovies",
 			args:        []string{"movies"},
 			expError:    nil,
-			expOut:      "Watchlist Movies:\n1. Title: Star Wars\nRelease Date: 1977-05-25\nPopularity: 20.152500\nVote Count: 21056\nVote Average: 8.203000\n\n2. Title: Fight Club\nRelease Date: 1999-10-15\nPopularity: 33.760600\nVote Count: 30142\nVote Average: 8.438000\n\n3. Title: Reservoir Dogs\nRelease Date: 1992-09-02\nPopularity: 10.440200\nVote Count: 14565\nVote Average: 8.122000\n\n4. Title: Cléo from 5 to 7\nRelease Date: 1962-04-11\nPopularity: 2.470800\nVote Count: 706\nVote Average: 7.700000\n\n",
+			expOut:      "Watchlist Movies:\n1. Title: Star Wars\nRelease Date: 1977-05-25\nGenres: Adventure, Action, Science Fiction\nPoster: https://image.tmdb.org/t/p/original/6FfCtAuVAW8XJjZ7eWeLibRLWTw.jpg\nPopularity: 20.152500\nVote Count: 21056\nVote Average: 8.203000\n\n2. Title: Fight Club\nRelease Date: 1999-10-15\nGenres: Drama\nPoster: https://image.tmdb.org/t/p/original/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg\nPopularity: 33.760600\nVote Count: 30142\nVote Average: 8.438000\n\n3. Title: Reservoir Dogs\nRelease Date: 1992-09-02\nGenres: Crime, Thriller\nPoster: https://image.tmdb.org/t/p/original/xi8Iu6qyTfyZVDVy60raIOYJJmk.jpg\nPopularity: 10.440200\nVote Count: 14565\nVote Average: 8.122000\n\n4. Title: Cléo from 5 to 7\nRelease Date: 1962-04-11\nGenres: Drama\nPoster: https://image.tmdb.org/t/p/original/oelBStY4xpguaplRv15P3Za7Xsr.jpg\nPopularity: 2.470800\nVote Count: 706\nVote Average: 7.700000\n\n",
 			resp:        testResp["resultsWatchlistMovies"],
-			isRaw:       false,
 			closeServer: false,
 		},
 		{
 			name:        "WatchlistTv",
 			args:        []string{"tv"},
 			expError:    nil,
-			expOut:      "Watchlist TV Shows:\n1. Name: Law & Order: Special Victims Unit\nFirst Air Date: 1999-09-20\nPopularity: 341.293000\nVote Count: 3905\nVote Average: 7.938000\n\n2. Name: Island at War\nFirst Air Date: 2004-07-11\nPopularity: 1.683700\nVote Count: 9\nVote Average: 7.400000\n\n",
+			expOut:      "Watchlist TV Shows:\n1. Name: Law & Order: Special Victims Unit\nFirst Air Date: 1999-09-20\nGenres: Crime, Drama, Mystery\nPoster: https://image.tmdb.org/t/p/original/abWOCrIo7bbAORxcQyOFNJdnnmR.jpg\nPopularity: 341.293000\nVote Count: 3905\nVote Average: 7.938000\n\n2. Name: Island at War\nFirst Air Date: 2004-07-11\nGenres: Drama, War & Politics\nPoster: https://image.tmdb.org/t/p/original/g47UV12d7sPUxkSF1ARrsYDJhta.jpg\nPopularity: 1.683700\nVote Count: 9\nVote Average: 7.400000\n\n",
 			resp:        testResp["resultsWatchlistTv"],
-			isRaw:       false,
 			closeServer: false,
 		},
+		{
+			name:     "WatchlistMoviesByGenreRaw",
+			args:     []string{"movies"},
+			genres:   []string{"crime"},
+			expError: nil,
+			expOut:   "{\n   \"page\": 1,\n   \"results\": [\n      {\n         \"adult\": false,\n         \"backdrop_path\": \"https://image.tmdb.org/t/p/original/jqFjgNnxpXIXWuPsyfqmcLXRo9p.jpg\",\n         \"genre_ids\": [\n            80,\n            53\n         ],\n         \"id\": 500,\n         \"original_language\": \"en\",\n         \"original_title\": \"Reservoir Dogs\",\n         \"overview\": \"A botched robbery indicates a police informant, and the pressure mounts in the aftermath at a warehouse. Crime begets violence as the survivors -- veteran Mr. White, newcomer Mr. Orange, psychopathic parolee Mr. Blonde, bickering weasel Mr. Pink and Nice Guy Eddie -- unravel.\",\n         \"popularity\": 10.4402,\n         \"poster_path\": \"https://image.tmdb.org/t/p/original/xi8Iu6qyTfyZVDVy60raIOYJJmk.jpg\",\n         \"release_date\": \"1992-09-02\",\n         \"title\": \"Reservoir Dogs\",\n         \"video\": false,\n         \"vote_average\": 8.122,\n         \"vote_count\": 14565\n      }\n   ],\n   \"total_pages\": 1,\n   \"total_results\": 4\n}",
+			resp:     testResp["resultsWatchlistMovies"],
+			format:   "json",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintf(w, tc.resp.Body)
 				})
@@ -327,7 +403,8 @@ func TestGetWatchlistAction(t *testing.T) {
 
 			var out bytes.Buffer
 
-			err := getWatchlistAction(&out, url, tc.args, tc.isRaw)
+			opts := outputOptions{format: tc.format, language: "en-US", genres: tc.genres}
+			err := getWatchlistAction(&out, url, tc.args, releaseOptions{}, opts)
 
 			if tc.expError != nil {
 				if err == nil {
@@ -392,7 +469,7 @@ func TestAddWatchlistAction(t *testing.T) {
 
 	var out bytes.Buffer
 
-	if err := addWatchlistAction(&out, url, args); err != nil {
+	if err := addWatchlistAction(&out, url, args, outputOptions{}); err != nil {
 		t.Fatalf("Expected no error, got %q", err)
 	}
 
@@ -405,20 +482,21 @@ func TestGetRatedAction(t *testing.T) {
 	testCases := []struct {
 		name     string
 		args     []string
+		genres   []string
 		expError error
 		expOut   string
 		resp     struct {
 			Status int
 			Body   string
 		}
-		isRaw       bool
+		format      string
 		closeServer bool
 	}{
 		{
 			name:     "RatedMovies",
 			args:     []string{"movies"},
 			expError: nil,
-			expOut:   "1. Title: The Wild Robot\nRelease Date: 2024-09-12\nPopularity: 64.82\nVote Count: 4716\nVote Average: 8.33\n\n2. Title: A Minecraft Movie\nRelease Date: 2025-03-31\nPopularity: 695.71\nVote Count: 499\nVote Average: 6.10\n\n",
+			expOut:   "1. Title: The Wild Robot\nRelease Date: 2024-09-12\nGenres: Animation, Science Fiction, Family\nPoster: https://image.tmdb.org/t/p/original/wTnV3PCVW5O92JMrFvvrRcV39RU.jpg\nPopularity: 64.82\nVote Count: 4716\nVote Average: 8.33\n\n2. Title: A Minecraft Movie\nRelease Date: 2025-03-31\nGenres: Family, Comedy, Adventure, Fantasy\nPoster: https://image.tmdb.org/t/p/original/yFHHfHcUgGAxziP1C3lLt0q2T4s.jpg\nPopularity: 695.71\nVote Count: 499\nVote Average: 6.10\n\n",
 			resp:     testResp["resultsGetRated"],
 		},
 	}
@@ -427,6 +505,9 @@ func TestGetRatedAction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintln(w, tc.resp.Body)
 				})
@@ -438,7 +519,8 @@ func TestGetRatedAction(t *testing.T) {
 
 			var out bytes.Buffer
 
-			err := getRatedAction(&out, url, tc.args, tc.isRaw)
+			opts := outputOptions{format: tc.format, language: "en-US", genres: tc.genres}
+			err := getRatedAction(&out, url, tc.args, opts)
 
 			if tc.expError != nil {
 				if err == nil {
@@ -472,15 +554,14 @@ func TestGetRatedEpisodesAction(t *testing.T) {
 			Status int
 			Body   string
 		}
-		isRaw       bool
+		format      string
 		closeServer bool
 	}{
 		{
 			name:     "RatedEpisodes",
 			expError: nil,
-			expOut:   "1. Name: The Long Night\nEps Number: 3\nAir Date: 2019-04-28\nVote Count: 308\nVote Average: 6.87\n\n2. Name: The Iron Throne\nEps Number: 6\nAir Date: 2019-05-19\nVote Count: 343\nVote Average: 4.57\n\n",
+			expOut:   "1. Name: The Long Night\nEps Number: 3\nAir Date: 2019-04-28\nStill: https://image.tmdb.org/t/p/original/mFtHbZenI5rRPqC5OFafoVmjEjq.jpg\nVote Count: 308\nVote Average: 6.87\n\n2. Name: The Iron Throne\nEps Number: 6\nAir Date: 2019-05-19\nStill: https://image.tmdb.org/t/p/original/zBi2O5EJfgTS6Ae0HdAYLm9o2nf.jpg\nVote Count: 343\nVote Average: 4.57\n\n",
 			resp:     testResp["resultsGetRatedEpisodes"],
-			isRaw:    false,
 		},
 	}
 
@@ -488,6 +569,9 @@ func TestGetRatedEpisodesAction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
 					w.WriteHeader(tc.resp.Status)
 					fmt.Fprintln(w, tc.resp.Body)
 				})
@@ -498,7 +582,7 @@ func TestGetRatedEpisodesAction(t *testing.T) {
 			}
 
 			var out bytes.Buffer
-			err := getRatedEpisodesAction(&out, url, tc.isRaw)
+			err := getRatedEpisodesAction(&out, url, outputOptions{format: tc.format})
 
 			if tc.expError != nil {
 				if err == nil {
@@ -522,3 +606,3627 @@ func TestGetRatedEpisodesAction(t *testing.T) {
 		})
 	}
 }
+
+func TestPersonDetailsAction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expError error
+		expOut   string
+		resp     struct {
+			Status int
+			Body   string
+		}
+		format    string
+		imageSize string
+	}{
+		{
+			name:     "PersonDetails",
+			args:     []string{"7467"},
+			expError: nil,
+			expOut:   "Person details for 7467\nName: David Fincher\nKnown For: Directing\nBirthday: 1962-08-28\nPlace of Birth: Denver, Colorado, USA\nPopularity: 5.67\nProfile: https://image.tmdb.org/t/p/original/tpEczFclQZeKAiCeKZZ0adRvtfz.jpg\nBiography: David Fincher is an American film director.\n",
+			resp:     testResp["resultsPersonDetails"],
+		},
+		{
+			name:      "ProfileImageSize",
+			args:      []string{"7467"},
+			imageSize: "h632",
+			expError:  nil,
+			expOut:    "Person details for 7467\nName: David Fincher\nKnown For: Directing\nBirthday: 1962-08-28\nPlace of Birth: Denver, Colorado, USA\nPopularity: 5.67\nProfile: https://image.tmdb.org/t/p/h632/tpEczFclQZeKAiCeKZZ0adRvtfz.jpg\nBiography: David Fincher is an American film director.\n",
+			resp:      testResp["resultsPersonDetails"],
+		},
+		{
+			name:      "InvalidImageSize",
+			args:      []string{"7467"},
+			imageSize: "huge",
+			expError:  configuration.ErrInvalidSize,
+			resp:      testResp["resultsPersonDetails"],
+		},
+		{
+			name:     "InvalidID",
+			args:     []string{"fincher"},
+			expError: strconv.ErrSyntax,
+			resp:     testResp["resultsPersonDetails"],
+		},
+		{
+			name:     "NotFound",
+			args:     []string{"1"},
+			expError: account.ErrNotFound,
+			resp: struct {
+				Status int
+				Body   string
+			}{Status: http.StatusNotFound, Body: "{}"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					w.WriteHeader(tc.resp.Status)
+					fmt.Fprintln(w, tc.resp.Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := personDetailsAction(&out, url, tc.args, outputOptions{format: tc.format, imageSize: tc.imageSize})
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestPersonCreditsAction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		filter    creditsOptions
+		noAccount bool
+		expError  error
+		expOut    string
+	}{
+		{
+			name:   "DirectorByDate",
+			filter: creditsOptions{mediaType: "combined", job: "director", sortBy: "date"},
+			expOut: "Credits for 7467\nCast:\nCrew:\n" +
+				"1. Title: Fight Club\nMedia Type: movie\nDepartment: Directing\nJob: Director\nDate: 1999-10-15\nPoster: https://image.tmdb.org/t/p/original/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg\nPopularity: 33.76\nRating: 9.0\nVote Average: 8.44\n\n" +
+				"2. Title: Se7en\nMedia Type: movie\nDepartment: Directing\nJob: Director\nDate: 1995-09-22\nPoster: https://image.tmdb.org/t/p/original/191nKfP0ehp3uIvWqgPbFmI4lv9.jpg\nPopularity: 21.30\nVote Average: 8.37\n\n",
+		},
+		{
+			name:   "ActingByPopularity",
+			filter: creditsOptions{mediaType: "combined", department: "acting", sortBy: "popularity"},
+			expOut: "Credits for 7467\nCast:\n" +
+				"1. Title: Visions of Light\nMedia Type: movie\nCharacter: Himself\nDate: 1992-04-02\nPoster: \nPopularity: 1.20\nVote Average: 7.10\n\n" +
+				"Crew:\n",
+		},
+		{
+			name:   "ExcludeRated",
+			filter: creditsOptions{mediaType: "combined", department: "directing", sortBy: "date", excludeRated: true},
+			expOut: "Credits for 7467\nCast:\nCrew:\n" +
+				"1. Title: Se7en\nMedia Type: movie\nDepartment: Directing\nJob: Director\nDate: 1995-09-22\nPoster: https://image.tmdb.org/t/p/original/191nKfP0ehp3uIvWqgPbFmI4lv9.jpg\nPopularity: 21.30\nVote Average: 8.37\n\n",
+		},
+		{
+			name:      "RatingsUnavailable",
+			filter:    creditsOptions{mediaType: "combined", job: "director", sortBy: "date"},
+			noAccount: true,
+			expOut: "Credits for 7467\nCast:\nCrew:\n" +
+				"1. Title: Fight Club\nMedia Type: movie\nDepartment: Directing\nJob: Director\nDate: 1999-10-15\nPoster: https://image.tmdb.org/t/p/original/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg\nPopularity: 33.76\nVote Average: 8.44\n\n" +
+				"2. Title: Se7en\nMedia Type: movie\nDepartment: Directing\nJob: Director\nDate: 1995-09-22\nPoster: https://image.tmdb.org/t/p/original/191nKfP0ehp3uIvWqgPbFmI4lv9.jpg\nPopularity: 21.30\nVote Average: 8.37\n\n",
+		},
+		{
+			name:      "ExcludeRatedUnavailable",
+			filter:    creditsOptions{mediaType: "combined", sortBy: "date", excludeRated: true},
+			noAccount: true,
+			expError:  account.ErrInvalidResponse,
+		},
+		{
+			name:     "InvalidMedia",
+			filter:   creditsOptions{mediaType: "books", sortBy: "date"},
+			expError: errors.New("invalid --media value"),
+		},
+		{
+			name:     "InvalidSort",
+			filter:   creditsOptions{mediaType: "combined", sortBy: "title"},
+			expError: person.ErrInvalidSort,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					if tc.noAccount && strings.HasPrefix(r.URL.Path, "/account/") {
+						w.WriteHeader(http.StatusUnauthorized)
+						fmt.Fprintln(w, `{"success":false,"status_code":3,"status_message":"Authentication failed"}`)
+						return
+					}
+					switch r.URL.Path {
+					case "/person/7467/combined_credits":
+						w.WriteHeader(testResp["resultsPersonCredits"].Status)
+						fmt.Fprintln(w, testResp["resultsPersonCredits"].Body)
+					case "/account/null/rated/movies":
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, `{"page":1,"results":[{"id":550,"title":"Fight Club","rating":9}],"total_pages":1,"total_results":1}`)
+					case "/account/null/rated/tv":
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, `{"page":1,"results":[{"id":67744,"name":"Mindhunter","rating":9}],"total_pages":1,"total_results":1}`)
+					default:
+						t.Errorf("Unexpected path %q", r.URL.Path)
+						w.WriteHeader(http.StatusNotFound)
+					}
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := personCreditsAction(&out, url, []string{"7467"}, tc.filter, outputOptions{})
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if err.Error() != tc.expError.Error() && !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestGenreListAction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		format   string
+		expError error
+		expOut   string
+	}{
+		{
+			name:   "TvGenres",
+			args:   []string{"tv"},
+			expOut: "Genres:\n1. Action & Adventure\n2. Animation\n3. Comedy\n4. Crime\n5. Documentary\n6. Drama\n7. Family\n8. Kids\n9. Mystery\n10. News\n11. Reality\n12. Sci-Fi & Fantasy\n13. Soap\n14. Talk\n15. War & Politics\n16. Western\n",
+		},
+		{
+			name:   "MovieGenresJSON",
+			args:   []string{"movie"},
+			format: "json",
+			expOut: `{
+   "genres": [
+      {
+         "id": 28,
+         "name": "Action"
+      },
+      {
+         "id": 12,
+         "name": "Adventure"
+      },
+      {
+         "id": 16,
+         "name": "Animation"
+      },
+      {
+         "id": 35,
+         "name": "Comedy"
+      },
+      {
+         "id": 80,
+         "name": "Crime"
+      },
+      {
+         "id": 99,
+         "name": "Documentary"
+      },
+      {
+         "id": 18,
+         "name": "Drama"
+      },
+      {
+         "id": 10751,
+         "name": "Family"
+      },
+      {
+         "id": 14,
+         "name": "Fantasy"
+      },
+      {
+         "id": 36,
+         "name": "History"
+      },
+      {
+         "id": 27,
+         "name": "Horror"
+      },
+      {
+         "id": 10402,
+         "name": "Music"
+      },
+      {
+         "id": 9648,
+         "name": "Mystery"
+      },
+      {
+         "id": 10749,
+         "name": "Romance"
+      },
+      {
+         "id": 878,
+         "name": "Science Fiction"
+      },
+      {
+         "id": 10770,
+         "name": "TV Movie"
+      },
+      {
+         "id": 53,
+         "name": "Thriller"
+      },
+      {
+         "id": 10752,
+         "name": "War"
+      },
+      {
+         "id": 37,
+         "name": "Western"
+      }
+   ]
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					if !serveReference(w, r) {
+						t.Errorf("Unexpected path %q", r.URL.Path)
+						w.WriteHeader(http.StatusNotFound)
+					}
+				})
+			defer cleanup()
+
+			// The second call must be served from the cache
+			for i := 0; i < 2; i++ {
+				var out bytes.Buffer
+
+				err := genreListAction(&out, url, tc.args, outputOptions{format: tc.format, language: "en-US"})
+
+				if tc.expError != nil {
+					if err == nil {
+						t.Fatalf("Expected error %q, got no error.", tc.expError)
+					}
+
+					if !errors.Is(err, tc.expError) {
+						t.Errorf("Expected error %q, got %q.", tc.expError, err)
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("Expected no error, got %q.", err)
+				}
+
+				if tc.expOut != out.String() {
+					t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+				}
+			}
+
+			if requests != 1 {
+				t.Errorf("Expected 1 request, got %d.", requests)
+			}
+		})
+	}
+}
+
+func TestImagesDownloadAction(t *testing.T) {
+	poster := []byte("poster-w500")
+	backdrop := []byte("backdrop-w780")
+
+	var url string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/configuration":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"images": {"secure_base_url": "%s/t/p/", "poster_sizes": ["w342", "w500", "original"], "backdrop_sizes": ["w300", "w780", "original"]}}`, url)
+			case "/movie/550":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, testMovieDetails)
+			case "/movie/194":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, `{"id": 194, "title": "", "original_title": "Amélie", "poster_path": null, "backdrop_path": ""}`)
+			case "/t/p/w500/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg":
+				w.Header().Set("Content-Length", strconv.Itoa(len(poster)))
+				w.Write(poster)
+			case "/t/p/w780/hZkgoQYus5vegHoetLkCJzb17zJ.jpg":
+				w.Header().Set("Content-Length", strconv.Itoa(len(backdrop)))
+				w.Write(backdrop)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	dir := t.TempDir()
+	dl := downloadOptions{
+		kinds:       []string{"poster", "backdrop"},
+		dir:         dir,
+		template:    "{{.Slug}}/{{.Kind}}-{{.Size}}{{.Ext}}",
+		manifest:    "manifest.json",
+		concurrency: 2,
+	}
+	opts := outputOptions{imageSize: "w400"}
+	args := []string{"movie", "550"}
+
+	expOut := func(status string) string {
+		return fmt.Sprintf("Images:\n1. fight-club/poster-w500.jpg (%s)\n2. fight-club/backdrop-w780.jpg (%s)\n", status, status)
+	}
+	summary := "Manifest: " + filepath.Join(dir, "manifest.json") + "\n"
+
+	var out bytes.Buffer
+	if err := imagesDownloadAction(&out, url, args, dl, opts); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp := expOut("downloaded") + "Downloaded: 2, Skipped: 0, Failed: 0\n" + summary
+	if exp != out.String() {
+		t.Errorf("Expected output %q, got %q.", exp, out.String())
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "fight-club", "poster-w500.jpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, poster) {
+		t.Errorf("Expected poster %q, got %q.", poster, got)
+	}
+
+	// Running again must not download anything
+	out.Reset()
+	if err := imagesDownloadAction(&out, url, args, dl, opts); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp = expOut("skipped") + "Downloaded: 0, Skipped: 2, Failed: 0\n" + summary
+	if exp != out.String() {
+		t.Errorf("Expected output %q, got %q.", exp, out.String())
+	}
+
+	// A modified file doesn't match the manifest anymore
+	if err := os.WriteFile(filepath.Join(dir, "fight-club", "poster-w500.jpg"), []byte("broken"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := imagesDownloadAction(&out, url, args, dl, opts); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp = "Images:\n1. fight-club/poster-w500.jpg (downloaded)\n2. fight-club/backdrop-w780.jpg (skipped)\nDownloaded: 1, Skipped: 1, Failed: 0\n" + summary
+	if exp != out.String() {
+		t.Errorf("Expected output %q, got %q.", exp, out.String())
+	}
+
+	m, err := images.LoadManifest(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Entries) != 2 || m.Entries[0].Bytes != int64(len(poster)) || m.Entries[0].SHA256 == "" {
+		t.Errorf("Unexpected manifest entries %+v.", m.Entries)
+	}
+
+	// Sizes missing on the server are reported as failures
+	out.Reset()
+	opts.imageSize = "w342"
+	err = imagesDownloadAction(&out, url, args, dl, opts)
+	if !errors.Is(err, images.ErrDownload) {
+		t.Errorf("Expected error %q, got %q.", images.ErrDownload, err)
+	}
+
+	// File names must stay inside the destination directory
+	dl.template = "../{{.ID}}{{.Ext}}"
+	err = imagesDownloadAction(&out, url, args, dl, opts)
+	if !errors.Is(err, images.ErrInvalidName) {
+		t.Errorf("Expected error %q, got %q.", images.ErrInvalidName, err)
+	}
+
+	// Movies have no stills, nothing would be downloaded
+	dl.kinds = []string{"still"}
+	err = imagesDownloadAction(&out, url, args, dl, opts)
+	if err == nil || !strings.Contains(err.Error(), "no still images") {
+		t.Errorf("Expected no still images error, got %v.", err)
+	}
+
+	// Nor would anything be for movies without images
+	dl.kinds = []string{"poster", "backdrop"}
+	err = imagesDownloadAction(&out, url, []string{"movie", "194"}, dl, opts)
+	if err == nil || !strings.Contains(err.Error(), "no poster or backdrop images") {
+		t.Errorf("Expected no poster or backdrop images error, got %v.", err)
+	}
+
+	// The untranslated titles fall back to the original ones
+	sources, err := getImageSources(url, []string{"movie", "194"}, "fr-FR")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if len(sources) != 1 || sources[0].title != "Amélie" {
+		t.Errorf("Expected the source titled %q, got %+v.", "Amélie", sources)
+	}
+}
+
+func TestRatingActions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		action     func(io.Writer, string, []string, outputOptions) error
+		args       []string
+		expURLPath string
+		expMethod  string
+		expBody    string
+		expError   error
+		expOut     string
+		resp       struct {
+			Status int
+			Body   string
+		}
+	}{
+		{
+			name:       "AddMovie",
+			action:     addRatingAction,
+			args:       []string{"movie", "550", "8.5"},
+			expURLPath: "/movie/550/rating",
+			expMethod:  http.MethodPost,
+			expBody:    "{\"value\":8.5}\n",
+			expOut:     "{\n   \"success\": true,\n   \"status_code\": 1,\n   \"status_message\": \"Success.\"\n}",
+			resp:       testResp["resultsAddRating"],
+		},
+		{
+			name:       "AddEpisode",
+			action:     addEpisodeRatingAction,
+			args:       []string{"1399", "8", "3", "4"},
+			expURLPath: "/tv/1399/season/8/episode/3/rating",
+			expMethod:  http.MethodPost,
+			expBody:    "{\"value\":4}\n",
+			expOut:     "{\n   \"success\": true,\n   \"status_code\": 1,\n   \"status_message\": \"Success.\"\n}",
+			resp:       testResp["resultsAddRating"],
+		},
+		{
+			name:       "DeleteTv",
+			action:     deleteRatingAction,
+			args:       []string{"tv", "1399"},
+			expURLPath: "/tv/1399/rating",
+			expMethod:  http.MethodDelete,
+			expOut:     "{\n   \"success\": true,\n   \"status_code\": 13,\n   \"status_message\": \"The item/record was deleted successfully.\"\n}",
+			resp:       testResp["resultsDeleteRating"],
+		},
+		{
+			name:       "DeleteEpisode",
+			action:     deleteEpisodeRatingAction,
+			args:       []string{"1399", "8", "6"},
+			expURLPath: "/tv/1399/season/8/episode/6/rating",
+			expMethod:  http.MethodDelete,
+			expOut:     "{\n   \"success\": true,\n   \"status_code\": 13,\n   \"status_message\": \"The item/record was deleted successfully.\"\n}",
+			resp:       testResp["resultsDeleteRating"],
+		},
+		{
+			name:     "TooLow",
+			action:   addRatingAction,
+			args:     []string{"movie", "550", "0"},
+			expError: account.ErrInvalid,
+		},
+		{
+			name:     "TooHigh",
+			action:   addEpisodeRatingAction,
+			args:     []string{"1399", "8", "3", "10.5"},
+			expError: account.ErrInvalid,
+		},
+		{
+			name:     "NotHalfStep",
+			action:   addRatingAction,
+			args:     []string{"tv", "1399", "7.3"},
+			expError: account.ErrInvalid,
+		},
+		{
+			name:     "NotNumber",
+			action:   addRatingAction,
+			args:     []string{"movie", "550", "great"},
+			expError: strconv.ErrSyntax,
+		},
+		{
+			// The IMDb id lookup would take a request
+			name:     "InvalidBeforeLookup",
+			action:   addRatingAction,
+			args:     []string{"movie", "tt0137523", "11"},
+			expError: account.ErrInvalid,
+		},
+		{
+			name:       "Failure",
+			action:     deleteRatingAction,
+			args:       []string{"movie", "550"},
+			expURLPath: "/movie/550/rating",
+			expMethod:  http.MethodDelete,
+			expError:   account.ErrInvalidResponse,
+			resp: struct {
+				Status int
+				Body   string
+			}{Status: http.StatusOK, Body: `{"success": false, "status_code": 34, "status_message": "The resource you requested could not be found."}`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if tc.expURLPath == "" {
+						t.Errorf("Unexpected request to %q", r.URL.Path)
+					}
+
+					if r.URL.Path != tc.expURLPath {
+						t.Errorf("Expected path %q, got %q", tc.expURLPath, r.URL.Path)
+					}
+
+					if r.Method != tc.expMethod {
+						t.Errorf("Expected method %q, got %q", tc.expMethod, r.Method)
+					}
+
+					body, err := io.ReadAll(r.Body)
+					if err != nil {
+						t.Fatal(err)
+					}
+					r.Body.Close()
+
+					if string(body) != tc.expBody {
+						t.Errorf("Expected body %q, got %q", tc.expBody, string(body))
+					}
+
+					w.WriteHeader(tc.resp.Status)
+					fmt.Fprintln(w, tc.resp.Body)
+				})
+			defer cleanup()
+
+			// Seed the ratings cache, a successful change must drop it
+			key := ratedCacheKey(url)
+			if err := cache.Put(key, []byte(`{"movie/550":9}`)); err != nil {
+				t.Fatal(err)
+			}
+
+			var out bytes.Buffer
+
+			err := tc.action(&out, url, tc.args, outputOptions{})
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				if _, ok := cache.Get(key, ratedCacheAge); !ok {
+					t.Errorf("Expected rated cache to be kept on error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+
+			if _, ok := cache.Get(key, ratedCacheAge); ok {
+				t.Errorf("Expected rated cache to be invalidated")
+			}
+		})
+	}
+}
+
+func TestRatingCacheBroken(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(testResp["resultsAddRating"].Status)
+			fmt.Fprintln(w, testResp["resultsAddRating"].Body)
+		})
+	defer cleanup()
+
+	// No cache directory can be found
+	t.Setenv("TMDB_CACHE_DIR", "")
+	t.Setenv("XDG_CACHE_HOME", "")
+	t.Setenv("HOME", "")
+
+	var out bytes.Buffer
+	if err := addRatingAction(&out, url, []string{"movie", "550", "8.5"}, outputOptions{}); err != nil {
+		t.Fatalf("Expected the saved rating not to fail on the cache, got %q.", err)
+	}
+}
+
+func TestRatedCacheKey(t *testing.T) {
+	t.Setenv("AUTH_TOKEN", "first-token")
+	first := ratedCacheKey("https://api.themoviedb.org/3")
+
+	t.Setenv("AUTH_TOKEN", "second-token")
+	second := ratedCacheKey("https://api.themoviedb.org/3")
+
+	if first == second {
+		t.Errorf("Expected the rated cache keys of two tokens to differ, got %q.", first)
+	}
+	if strings.Contains(second, "second-token") {
+		t.Errorf("Expected the token to be hashed, got key %q.", second)
+	}
+}
+
+func TestListShowAction(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if serveReference(w, r) {
+				return
+			}
+
+			if r.URL.Path != "/list/8521773" {
+				t.Errorf("Expected path %q, got %q", "/list/8521773", r.URL.Path)
+			}
+
+			if page := r.URL.Query().Get("page"); page != "2" {
+				t.Errorf("Expected page %q, got %q", "2", page)
+			}
+
+			w.WriteHeader(testResp["resultsListDetails"].Status)
+			fmt.Fprintln(w, testResp["resultsListDetails"].Body)
+		})
+	defer cleanup()
+
+	expOut := "List: my-list\nDescription: test my list\nCreated By: clairvoyance27\nTotal Items: 2\nItems:\n" +
+		"1. Title: Fight Club\nID: 550\nDate: 1999-10-15\nVote Average: 8.44\n\n" +
+		"2. Title: Star Wars\nID: 11\nDate: 1977-05-25\nVote Average: 8.20\n\n"
+
+	var out bytes.Buffer
+
+	if err := listShowAction(&out, url, []string{"8521773", "2"}, outputOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if expOut != out.String() {
+		t.Errorf("Expected output %q, got %q.", expOut, out.String())
+	}
+}
+
+func TestListShowPages(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if serveReference(w, r) {
+				return
+			}
+
+			n, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			titles := []string{"Fight Club", "Star Wars"}
+			fmt.Fprintf(w, `{"name": "my-list", "item_count": 2, "page": %d, "items": [{"id": %d, "title": %q, "media_type": "movie"}], "total_pages": 2, "total_results": 2}`,
+				n, n, titles[n-1])
+		})
+	defer cleanup()
+
+	expOut := "List: my-list\nDescription: \nCreated By: \nTotal Items: 2\nItems:\n" +
+		"1. Title: Fight Club\nID: 1\nDate: \nVote Average: 0.00\n\n" +
+		"2. Title: Star Wars\nID: 2\nDate: \nVote Average: 0.00\n\n"
+
+	var out bytes.Buffer
+
+	if err := listShowAction(&out, url, []string{"8521773"}, outputOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if expOut != out.String() {
+		t.Errorf("Expected output %q, got %q.", expOut, out.String())
+	}
+}
+
+func TestListActions(t *testing.T) {
+	type request struct {
+		path   string
+		method string
+		body   string
+		status int
+		resp   string
+	}
+
+	testCases := []struct {
+		name     string
+		action   func(io.Writer, string) error
+		requests []request
+		expError error
+		expOut   string
+	}{
+		{
+			name: "Create",
+			action: func(out io.Writer, url string) error {
+				return listCreateAction(out, url, []string{"weekend"}, "movies for the weekend", outputOptions{language: "en-US"})
+			},
+			requests: []request{
+				{"/list", http.MethodPost, "{\"name\":\"weekend\",\"description\":\"movies for the weekend\",\"language\":\"en\"}\n",
+					http.StatusCreated, `{"status_message": "The item/record was created successfully.", "success": true, "status_code": 1, "list_id": 8527130}`},
+			},
+			expOut: "{\n   \"success\": true,\n   \"status_code\": 1,\n   \"status_message\": \"The item/record was created successfully.\",\n   \"list_id\": 8527130\n}",
+		},
+		{
+			name: "AddItems",
+			action: func(out io.Writer, url string) error {
+				return listItemsAction(out, url, []string{"8527130", "550", "11"}, list.AddItem, outputOptions{})
+			},
+			requests: []request{
+				{"/list/8527130/add_item", http.MethodPost, "{\"media_id\":550}\n",
+					http.StatusCreated, `{"success": true, "status_code": 12, "status_message": "The item/record was updated successfully."}`},
+				{"/list/8527130/add_item", http.MethodPost, "{\"media_id\":11}\n",
+					http.StatusForbidden, `{"success": false, "status_code": 8, "status_message": "Duplicate entry: The data you tried to submit already exists."}`},
+			},
+			expError: list.ErrInvalidResponse,
+			expOut:   "[\n   {\n      \"media_id\": 550,\n      \"success\": true,\n      \"status_code\": 12,\n      \"status_message\": \"The item/record was updated successfully.\"\n   },\n   {\n      \"media_id\": 11,\n      \"success\": false,\n      \"status_message\": \"invalid server response: {\\\"success\\\": false, \\\"status_code\\\": 8, \\\"status_message\\\": \\\"Duplicate entry: The data you tried to submit already exists.\\\"}\\n\"\n   }\n]",
+		},
+		{
+			name: "RemoveItem",
+			action: func(out io.Writer, url string) error {
+				return listItemsAction(out, url, []string{"8527130", "550"}, list.RemoveItem, outputOptions{})
+			},
+			requests: []request{
+				{"/list/8527130/remove_item", http.MethodPost, "{\"media_id\":550}\n",
+					http.StatusOK, `{"success": true, "status_code": 13, "status_message": "The item/record was deleted successfully."}`},
+			},
+			expOut: "[\n   {\n      \"media_id\": 550,\n      \"success\": true,\n      \"status_code\": 13,\n      \"status_message\": \"The item/record was deleted successfully.\"\n   }\n]",
+		},
+		{
+			name: "InvalidMovieID",
+			action: func(out io.Writer, url string) error {
+				return listItemsAction(out, url, []string{"8527130", "fight-club"}, list.AddItem, outputOptions{})
+			},
+			expError: strconv.ErrSyntax,
+		},
+		{
+			name: "Clear",
+			action: func(out io.Writer, url string) error {
+				return listClearAction(out, url, []string{"8527130"}, true, outputOptions{})
+			},
+			requests: []request{
+				{"/list/8527130/clear", http.MethodPost, "",
+					http.StatusCreated, `{"success": true, "status_code": 12, "status_message": "The item/record was updated successfully."}`},
+			},
+			expOut: "{\n   \"success\": true,\n   \"status_code\": 12,\n   \"status_message\": \"The item/record was updated successfully.\"\n}",
+		},
+		{
+			name: "ClearNotConfirmed",
+			action: func(out io.Writer, url string) error {
+				return listClearAction(out, url, []string{"8527130"}, false, outputOptions{})
+			},
+			expError: errNotConfirmed,
+		},
+		{
+			name: "Delete",
+			action: func(out io.Writer, url string) error {
+				return listDeleteAction(out, url, []string{"8527130"}, true, outputOptions{})
+			},
+			requests: []request{
+				{"/list/8527130", http.MethodDelete, "",
+					http.StatusOK, `{"success": true, "status_code": 13, "status_message": "The item/record was deleted successfully."}`},
+			},
+			expOut: "{\n   \"success\": true,\n   \"status_code\": 13,\n   \"status_message\": \"The item/record was deleted successfully.\"\n}",
+		},
+		{
+			name: "DeleteNotConfirmed",
+			action: func(out io.Writer, url string) error {
+				return listDeleteAction(out, url, []string{"8527130"}, false, outputOptions{})
+			},
+			expError: errNotConfirmed,
+		},
+		{
+			name: "Status",
+			action: func(out io.Writer, url string) error {
+				return listStatusAction(out, url, []string{"8527130", "550"}, outputOptions{})
+			},
+			requests: []request{
+				{"/list/8527130/item_status", http.MethodGet, "",
+					http.StatusOK, `{"id": "8527130", "item_present": true}`},
+			},
+			expOut: "Movie 550 in list 8527130: yes\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := 0
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if n >= len(tc.requests) {
+						t.Errorf("Unexpected request to %q", r.URL.Path)
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					req := tc.requests[n]
+					n++
+
+					if r.URL.Path != req.path {
+						t.Errorf("Expected path %q, got %q", req.path, r.URL.Path)
+					}
+
+					if r.Method != req.method {
+						t.Errorf("Expected method %q, got %q", req.method, r.Method)
+					}
+
+					body, err := io.ReadAll(r.Body)
+					if err != nil {
+						t.Fatal(err)
+					}
+					r.Body.Close()
+
+					if string(body) != req.body {
+						t.Errorf("Expected body %q, got %q", req.body, string(body))
+					}
+
+					w.WriteHeader(req.status)
+					fmt.Fprintln(w, req.resp)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := tc.action(&out, url)
+
+			if n != len(tc.requests) {
+				t.Errorf("Expected %d requests, got %d.", len(tc.requests), n)
+			}
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestStateAction(t *testing.T) {
+	states := map[string]string{
+		"/movie/550/account_states":                  `{"id": 550, "favorite": true, "rated": {"value": 8.5}, "watchlist": false}`,
+		"/tv/1399/account_states":                    `{"id": 1399, "favorite": false, "rated": false, "watchlist": true}`,
+		"/tv/2734/account_states":                    `{"id": 2734, "favorite": true, "rated": {"value": 10.0}, "watchlist": true}`,
+		"/tv/1399/season/8/episode/3/account_states": `{"id": 1551827, "rated": {"value": 4.0}}`,
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		episode  bool
+		format   string
+		expError error
+		expOut   string
+	}{
+		{
+			name:   "Movie",
+			args:   []string{"movie", "550"},
+			expOut: "Account state for movie 550\nFavorite: yes\nWatchlist: no\nRating: 8.5\n",
+		},
+		{
+			name: "TvMatrix",
+			args: []string{"tv", "1399", "2734"},
+			expOut: "ID    Favorite  Watchlist  Rating\n" +
+				"1399  no        yes        -\n" +
+				"2734  yes       yes        10.0\n",
+		},
+		{
+			name:   "TvMatrixRaw",
+			args:   []string{"tv", "1399", "2734"},
+			format: "json",
+			expOut: "[\n   {\n      \"id\": 1399,\n      \"favorite\": false,\n      \"rated\": false,\n      \"watchlist\": true\n   },\n   {\n      \"id\": 2734,\n      \"favorite\": true,\n      \"rated\": {\n         \"value\": 10\n      },\n      \"watchlist\": true\n   }\n]",
+		},
+		{
+			name:    "Episode",
+			args:    []string{"1399", "8", "3"},
+			episode: true,
+			expOut:  "Account state for episode 1551827\nRating: 4.0\n",
+		},
+		{
+			name:     "InvalidMediaType",
+			args:     []string{"person", "287"},
+			expError: errors.New("invalid <media_type> value"),
+		},
+		{
+			name:     "NotFound",
+			args:     []string{"movie", "550", "1"},
+			expError: account.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					body, ok := states[r.URL.Path]
+					if !ok {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprintln(w, body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			opts := outputOptions{format: tc.format}
+			var err error
+			if tc.episode {
+				err = stateEpisodeAction(&out, url, tc.args, opts)
+			} else {
+				err = stateAction(&out, url, tc.args, opts)
+			}
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if err.Error() != tc.expError.Error() && !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestProvidersAction(t *testing.T) {
+	providers := map[string]string{
+		"/movie/550/watch/providers": `{"id": 550, "results": {
+  "ID": {"link": "https://www.themoviedb.org/movie/550/watch?locale=ID",
+    "flatrate": [{"display_priority": 1, "logo_path": "/n.jpg", "provider_id": 8, "provider_name": "Netflix"}],
+    "buy": [{"display_priority": 2, "logo_path": "/a.jpg", "provider_id": 2, "provider_name": "Apple TV"}]},
+  "US": {"link": "https://www.themoviedb.org/movie/550/watch?locale=US",
+    "rent": [{"display_priority": 1, "logo_path": "/a.jpg", "provider_id": 2, "provider_name": "Apple TV"}]}}}`,
+		"/movie/11/watch/providers": `{"id": 11, "results": {
+  "ID": {"flatrate": [{"display_priority": 1, "logo_path": "/d.jpg", "provider_id": 337, "provider_name": "Disney Plus"}]}}}`,
+		"/movie/500/watch/providers": `{"id": 500, "results": {
+  "ID": {"flatrate": [{"display_priority": 1, "logo_path": "/n.jpg", "provider_id": 8, "provider_name": "Netflix"}]}}}`,
+		"/movie/499/watch/providers": `{"id": 499, "results": {
+  "ID": {"free": [{"display_priority": 1, "logo_path": "/t.jpg", "provider_id": 73, "provider_name": "Tubi TV"}]}}}`,
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		watchlist bool
+		popts     providerOptions
+		format    string
+		expError  error
+		expOut    string
+	}{
+		{
+			name:   "AccountRegion",
+			args:   []string{"movie", "550"},
+			expOut: "Watch providers for movie 550 in ID\nStream: Netflix\nRent: \nBuy: Apple TV\nLink: https://www.themoviedb.org/movie/550/watch?locale=ID\n",
+		},
+		{
+			name:   "Region",
+			args:   []string{"movie", "550"},
+			popts:  providerOptions{region: "us"},
+			expOut: "Watch providers for movie 550 in US\nStream: \nRent: Apple TV\nBuy: \nLink: https://www.themoviedb.org/movie/550/watch?locale=US\n",
+		},
+		{
+			name:   "Free",
+			args:   []string{"movie", "499"},
+			expOut: "Watch providers for movie 499 in ID\nStream: \nRent: \nBuy: \nFree: Tubi TV\n",
+		},
+		{
+			name:   "ServiceRaw",
+			args:   []string{"movie", "550"},
+			popts:  providerOptions{services: []string{"netflix"}},
+			format: "json",
+			expOut: "{\n   \"id\": 550,\n   \"results\": {\n      \"ID\": {\n         \"link\": \"https://www.themoviedb.org/movie/550/watch?locale=ID\",\n         \"flatrate\": [\n            {\n               \"display_priority\": 1,\n               \"logo_path\": \"/n.jpg\",\n               \"provider_id\": 8,\n               \"provider_name\": \"Netflix\"\n            }\n         ]\n      }\n   }\n}",
+		},
+		{
+			name:      "Watchlist",
+			args:      []string{"movies"},
+			watchlist: true,
+			expOut: "Watchlist providers in ID\nStream:\n  Disney Plus:\n  - Star Wars\n  Netflix:\n  - Fight Club\n  - Reservoir Dogs\n" +
+				"Rent:\nBuy:\n  Apple TV:\n  - Fight Club\nFree:\n  Tubi TV:\n  - Cléo from 5 to 7\nNot Available:\n",
+		},
+		{
+			name:      "WatchlistService",
+			args:      []string{"movies"},
+			watchlist: true,
+			popts:     providerOptions{services: []string{"Disney Plus"}},
+			expOut: "Watchlist providers in ID\nStream:\n  Disney Plus:\n  - Star Wars\nRent:\nBuy:\n" +
+				"Not Available:\n  - Fight Club\n  - Reservoir Dogs\n  - Cléo from 5 to 7\n",
+		},
+		{
+			name:     "InvalidMediaType",
+			args:     []string{"person", "287"},
+			expError: errors.New("invalid <media_type> value"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					var resp struct {
+						Status int
+						Body   string
+					}
+					switch r.URL.Path {
+					case "/account/null":
+						resp = testResp["resultsDetails"]
+					case "/account/null/watchlist/movies":
+						resp = testResp["resultsWatchlistMovies"]
+					default:
+						body, ok := providers[r.URL.Path]
+						if !ok {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						resp.Status, resp.Body = http.StatusOK, body
+					}
+					w.WriteHeader(resp.Status)
+					fmt.Fprintln(w, resp.Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			opts := outputOptions{format: tc.format}
+			var err error
+			if tc.watchlist {
+				err = watchlistProvidersAction(&out, url, tc.args, tc.popts, opts)
+			} else {
+				err = providersAction(&out, url, tc.args, tc.popts, opts)
+			}
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if err.Error() != tc.expError.Error() && !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestProviderOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		env      string
+		args     []string
+		expError bool
+		exp      []string
+	}{
+		{name: "None"},
+		{name: "Flag", args: []string{"--service", `Netflix,"Disney Plus"`}, exp: []string{"Netflix", "Disney Plus"}},
+		{name: "Env", env: `Netflix,Disney Plus`, exp: []string{"Netflix", "Disney Plus"}},
+		{name: "FlagOverEnv", env: "Netflix", args: []string{"--service", "Apple TV"}, exp: []string{"Apple TV"}},
+		{name: "InvalidEnv", env: `"Netflix`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("TMDB_SERVICE", tc.env)
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringSlice("service", nil, "")
+			if err := cmd.Flags().Parse(tc.args); err != nil {
+				t.Fatal(err)
+			}
+
+			popts, err := getProviderOptions(cmd)
+
+			if tc.expError {
+				if err == nil {
+					t.Fatal("Expected an error, got none.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if strings.Join(popts.services, "|") != strings.Join(tc.exp, "|") {
+				t.Errorf("Expected services %q, got %q.", tc.exp, popts.services)
+			}
+		})
+	}
+}
+
+func TestRecommendAction(t *testing.T) {
+	shrek := `{"id": 1, "title": "Shrek", "release_date": "2001-05-18", "vote_average": 7.7}`
+	up := `{"id": 2, "title": "Up", "release_date": "2009-05-28", "vote_average": 8.0}`
+	heat := `{"id": 3, "title": "Heat", "release_date": "1995-12-15", "vote_average": 7.9}`
+	fightClub := `{"id": 550, "title": "Fight Club", "release_date": "1999-10-15", "vote_average": 8.4}`
+	absolut := `{"id": 555, "title": "Absolut", "release_date": "2005-01-20", "vote_average": 5.6}`
+
+	lists := map[string][]string{
+		"/movie/1184918/recommendations": {shrek, fightClub},
+		"/movie/1184918/similar":         {up},
+		"/movie/950387/recommendations":  {shrek},
+		"/movie/950387/similar":          {},
+		"/movie/1165067/recommendations": {up, absolut},
+		"/movie/1165067/similar":         {},
+		"/movie/555/recommendations":     {heat},
+		"/movie/555/similar":             {shrek},
+	}
+
+	testCases := []struct {
+		name     string
+		ropts    recommendOptions
+		notFound string
+		format   string
+		expError error
+		expOut   string
+	}{
+		{
+			name:  "Ranked",
+			ropts: recommendOptions{minRating: 7},
+			expOut: "Recommended Movies:\n" +
+				"1. Title: Shrek\nRelease Date: 2001-05-18\nVote Average: 7.70\nScore: 2.60\nBecause you liked Absolut, A Minecraft Movie, The Wild Robot\n\n" +
+				"2. Title: Up\nRelease Date: 2009-05-28\nVote Average: 8.00\nScore: 1.80\nBecause you liked Cosmic Chaos, The Wild Robot\n\n" +
+				"3. Title: Heat\nRelease Date: 1995-12-15\nVote Average: 7.90\nScore: 1.00\nBecause you liked Absolut\n\n",
+		},
+		{
+			name:   "MinRatingLimitRaw",
+			ropts:  recommendOptions{minRating: 9, limit: 2},
+			format: "json",
+			expOut: "[\n   {\n      \"id\": 2,\n      \"title\": \"Up\",\n      \"release_date\": \"2009-05-28\",\n      \"vote_average\": 8,\n      \"score\": 1,\n      \"because\": [\n         \"Cosmic Chaos\"\n      ]\n   },\n" +
+				"   {\n      \"id\": 3,\n      \"title\": \"Heat\",\n      \"release_date\": \"1995-12-15\",\n      \"vote_average\": 7.9,\n      \"score\": 1,\n      \"because\": [\n         \"Absolut\"\n      ]\n   }\n]",
+		},
+		{
+			name:     "NotFound",
+			ropts:    recommendOptions{minRating: 7},
+			notFound: "/movie/555/similar",
+			expError: account.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					var resp struct {
+						Status int
+						Body   string
+					}
+					switch r.URL.Path {
+					case "/account/null/favorite/movies":
+						resp = testResp["resultsFavMovies"]
+					case "/account/null/rated/movies":
+						resp = testResp["resultsGetRated"]
+					case "/account/null/watchlist/movies":
+						resp = testResp["resultsWatchlistMovies"]
+					default:
+						results, ok := lists[r.URL.Path]
+						if !ok || r.URL.Path == tc.notFound {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						resp.Status = http.StatusOK
+						resp.Body = fmt.Sprintf(`{"page": 1, "results": [%s], "total_pages": 1, "total_results": %d}`,
+							strings.Join(results, ","), len(results))
+					}
+					w.WriteHeader(resp.Status)
+					fmt.Fprintln(w, resp.Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			opts := outputOptions{format: tc.format}
+			err := recommendAction(&out, url, tc.ropts, opts)
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestAggregateRecommendationsSameTitle(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			results := ""
+			if strings.HasSuffix(r.URL.Path, "/recommendations") {
+				results = `{"id": 10, "title": "The Fog", "release_date": "1980-02-08", "vote_average": 6.6}`
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"page": 1, "results": [%s], "total_pages": 1, "total_results": 1}`, results)
+		})
+	defer cleanup()
+
+	// The remake shares the title of the original
+	seeds := []recommendSeed{{948, "Halloween", 0.8}, {424139, "Halloween", 1}, {4232, "Scream", 0.9}}
+
+	recs, err := aggregateRecommendations(url, "en-US", seeds, map[int]bool{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if len(recs) != 1 {
+		t.Fatalf("Expected 1 recommendation, got %d.", len(recs))
+	}
+
+	expBecause := []string{"Halloween", "Scream", "Halloween"}
+	if !slices.Equal(expBecause, recs[0].Because) {
+		t.Errorf("Expected because %q, got %q.", expBecause, recs[0].Because)
+	}
+}
+
+func TestBrowseActions(t *testing.T) {
+	responses := map[string]string{
+		"/collection/1": `{"id": 1, "name": "The Wild Robot Collection", "overview": "Roz the robot.", "poster_path": "/wr.jpg", "backdrop_path": null,
+  "parts": [
+    {"id": 9, "title": "The Wild Robot Escapes", "release_date": "2026-09-18", "vote_average": 0, "poster_path": null},
+    {"id": 1184918, "title": "The Wild Robot", "release_date": "2024-09-12", "vote_average": 8.4, "poster_path": "/r.jpg"}
+  ]}`,
+		"/collection/2": `{"id": 2, "name": "Absolut Collection", "parts": [
+    {"id": 7, "title": "Absolut 2", "release_date": ""},
+    {"id": 555, "title": "Absolut", "release_date": "2005-01-20"},
+    {"id": 550, "title": "Fight Club", "release_date": "1999-10-15"}
+  ]}`,
+		"/movie/1184918": `{"id": 1184918, "title": "The Wild Robot", "belongs_to_collection": {"id": 1, "name": "The Wild Robot Collection"}}`,
+		"/movie/950387":  `{"id": 950387, "title": "A Minecraft Movie", "belongs_to_collection": null}`,
+		"/movie/1165067": `{"id": 1165067, "title": "Cosmic Chaos", "belongs_to_collection": null}`,
+		"/movie/555":     `{"id": 555, "title": "Absolut", "belongs_to_collection": {"id": 2, "name": "Absolut Collection"}}`,
+		"/company/420": `{"description": "", "headquarters": "Burbank, California, United States", "homepage": "https://www.marvel.com",
+  "id": 420, "logo_path": "/hUzeosd33nzE5MCNsZxCGEKTXaQ.png", "name": "Marvel Studios", "origin_country": "US",
+  "parent_company": {"id": 2, "logo_path": "/wdrCwmRnLFJhEoH8GSfymY85KHT.png", "name": "Walt Disney Pictures"}}`,
+		"/discover/movie": `{"page": 2, "results": [{"id": 299534, "title": "Avengers: Endgame", "release_date": "2019-04-24", "poster_path": "/or06FN3Dka5tukK1e9sl16pB3iy.jpg", "vote_average": 8.2}],
+  "total_pages": 12, "total_results": 231}`,
+		"/network/49": `{"headquarters": "New York City, New York", "homepage": "https://www.hbo.com", "id": 49,
+  "logo_path": "/tuomPhY2UtuPTqqFnKMVHvSb724.png", "name": "HBO", "origin_country": "US"}`,
+	}
+
+	testCases := []struct {
+		name     string
+		action   func(io.Writer, string) error
+		expQuery string
+		expError error
+		expOut   string
+	}{
+		{
+			name: "CollectionDetails",
+			action: func(out io.Writer, url string) error {
+				return collectionDetailsAction(out, url, []string{"1"}, outputOptions{imageSize: "w500"})
+			},
+			expOut: "Collection details for 1\nName: The Wild Robot Collection\nPoster: https://image.tmdb.org/t/p/w500/wr.jpg\nOverview: Roz the robot.\nMovies:\n" +
+				"1. Title: The Wild Robot Escapes\nID: 9\nRelease Date: 2026-09-18\nVote Average: 0.00\n\n" +
+				"2. Title: The Wild Robot\nID: 1184918\nRelease Date: 2024-09-12\nVote Average: 8.40\n\n",
+		},
+		{
+			name: "CollectionNotFound",
+			action: func(out io.Writer, url string) error {
+				return collectionDetailsAction(out, url, []string{"3"}, outputOptions{})
+			},
+			expError: collection.ErrNotFound,
+		},
+		{
+			name: "CollectionMismatch",
+			action: func(out io.Writer, url string) error {
+				return collectionDetailsAction(out, url, []string{"movie/550"}, outputOptions{})
+			},
+			expError: errMediaTypeMismatch,
+		},
+		{
+			name: "CollectionCompletion",
+			action: func(out io.Writer, url string) error {
+				return collectionCompletionAction(out, url, outputOptions{})
+			},
+			expOut: "Absolut Collection (2)\nRated: 0/3, Favorite: 1, Watchlist: 1, Missing: 1\n" +
+				"ID   Title       Release Date  Status\n" +
+				"550  Fight Club  1999-10-15    watchlist\n" +
+				"555  Absolut     2005-01-20    favorite\n" +
+				"7    Absolut 2                 missing\n" +
+				"\nThe Wild Robot Collection (1)\nRated: 1/2, Favorite: 0, Watchlist: 0, Missing: 1\n" +
+				"ID       Title                   Release Date  Status\n" +
+				"1184918  The Wild Robot          2024-09-12    rated 8.0\n" +
+				"9        The Wild Robot Escapes  2026-09-18    missing\n",
+		},
+		{
+			name: "CompanyDetails",
+			action: func(out io.Writer, url string) error {
+				return companyDetailsAction(out, url, []string{"https://www.themoviedb.org/company/420-marvel-studios"}, outputOptions{})
+			},
+			expOut: "Company details for 420\nName: Marvel Studios\nHeadquarters: Burbank, California, United States\nOrigin Country: US\n" +
+				"Homepage: https://www.marvel.com\nParent Company: Walt Disney Pictures\nLogo: https://image.tmdb.org/t/p/original/hUzeosd33nzE5MCNsZxCGEKTXaQ.png\nDescription: \n",
+		},
+		{
+			name: "CompanyMovies",
+			action: func(out io.Writer, url string) error {
+				return companyMoviesAction(out, url, []string{"company/420", "2"}, outputOptions{language: "fr-FR"})
+			},
+			expQuery: "language=fr-FR&page=2&sort_by=popularity.desc&with_companies=420",
+			expOut: "Movies by company 420 (page 2 of 12)\n1. Title: Avengers: Endgame\nID: 299534\nRelease Date: 2019-04-24\n" +
+				"Poster: https://image.tmdb.org/t/p/original/or06FN3Dka5tukK1e9sl16pB3iy.jpg\nVote Average: 8.20\n\n",
+		},
+		{
+			name: "NetworkDetailsRaw",
+			action: func(out io.Writer, url string) error {
+				return networkDetailsAction(out, url, []string{"network/49"}, outputOptions{format: "json", imageSize: "w92"})
+			},
+			expOut: "{\n   \"headquarters\": \"New York City, New York\",\n   \"homepage\": \"https://www.hbo.com\",\n   \"id\": 49,\n" +
+				"   \"logo_path\": \"https://image.tmdb.org/t/p/w92/tuomPhY2UtuPTqqFnKMVHvSb724.png\",\n   \"name\": \"HBO\",\n   \"origin_country\": \"US\"\n}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+
+					var resp struct {
+						Status int
+						Body   string
+					}
+					switch r.URL.Path {
+					case "/account/null/favorite/movies":
+						resp = testResp["resultsFavMovies"]
+					case "/account/null/rated/movies":
+						resp = testResp["resultsGetRated"]
+					case "/account/null/watchlist/movies":
+						resp = testResp["resultsWatchlistMovies"]
+					default:
+						body, ok := responses[r.URL.Path]
+						if !ok {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						if tc.expQuery != "" && r.URL.RawQuery != tc.expQuery {
+							t.Errorf("Expected query %q, got %q", tc.expQuery, r.URL.RawQuery)
+						}
+						resp.Status, resp.Body = http.StatusOK, body
+					}
+					w.WriteHeader(resp.Status)
+					fmt.Fprintln(w, resp.Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := tc.action(&out, url)
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestReviewsAction(t *testing.T) {
+	body := `{"id": 550, "page": 2, "total_pages": 2, "total_results": 3, "results": [
+  {"author": "Goddard", "author_details": {"name": "", "username": "Goddard", "avatar_path": null, "rating": null},
+   "content": "Pretty awesome movie. It shows what one crazy person can convince other crazy people to do.\r\nEveryone needs something to believe in.",
+   "created_at": "2018-06-09T17:51:53.359Z", "id": "5b1c13b9c3a36848f2026384", "updated_at": "2021-06-23T15:58:09.421Z",
+   "url": "https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384"},
+  {"author": "Brett Pascoe", "author_details": {"name": "Brett Pascoe", "username": "Brett", "avatar_path": null, "rating": 9.0},
+   "content": "In my top 5 of all time favourite movies.\n\nGreat story line and a movie you can watch over and over again.",
+   "created_at": "2021-06-21T05:21:30.000Z", "id": "60d02f1a", "updated_at": "2021-06-23T15:58:09.421Z",
+   "url": "https://www.themoviedb.org/review/60d02f1a"}
+]}`
+
+	testCases := []struct {
+		name     string
+		args     []string
+		ropts    reviewsOptions
+		expError error
+		expOut   string
+	}{
+		{
+			name:  "Truncated",
+			args:  []string{"movie", "550", "2"},
+			ropts: reviewsOptions{width: 40},
+			expOut: "Reviews for movie 550 (page 2 of 2, 3 reviews)\n" +
+				"1. Author: Goddard\nRating: -\nCreated: 2018-06-09\nURL: https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384\n" +
+				"   Pretty awesome movie. It shows what\n   one crazy person can convince other\n   crazy people to do.\n   Everyone needs something to believe\n   in.\n\n" +
+				"2. Author: Brett Pascoe\nRating: 9.0\nCreated: 2021-06-21\nURL: https://www.themoviedb.org/review/60d02f1a\n" +
+				"   In my top 5 of all time favourite\n   movies.\n\n   Great story line and a movie you can\n   watch over and over again.\n\n",
+		},
+		{
+			name:  "Narrow",
+			args:  []string{"movie", "https://www.themoviedb.org/movie/550-fight-club", "2"},
+			ropts: reviewsOptions{width: 23},
+			expOut: "Reviews for movie 550 (page 2 of 2, 3 reviews)\n" +
+				"1. Author: Goddard\nRating: -\nCreated: 2018-06-09\nURL: https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384\n" +
+				"   Pretty awesome\n   movie. It shows what\n   one crazy person can\n   convince other crazy\n   people to do.\n   Everyone needs\n   [...] use --full to read the whole review\n\n" +
+				"2. Author: Brett Pascoe\nRating: 9.0\nCreated: 2021-06-21\nURL: https://www.themoviedb.org/review/60d02f1a\n" +
+				"   In my top 5 of all\n   time favourite\n   movies.\n\n   Great story line and\n   a movie you can\n   [...] use --full to read the whole review\n\n",
+		},
+		{
+			name:  "Full",
+			args:  []string{"movie", "550", "2"},
+			ropts: reviewsOptions{width: 23, full: true},
+			expOut: "Reviews for movie 550 (page 2 of 2, 3 reviews)\n" +
+				"1. Author: Goddard\nRating: -\nCreated: 2018-06-09\nURL: https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384\n" +
+				"   Pretty awesome\n   movie. It shows what\n   one crazy person can\n   convince other crazy\n   people to do.\n   Everyone needs\n   something to believe\n   in.\n\n" +
+				"2. Author: Brett Pascoe\nRating: 9.0\nCreated: 2021-06-21\nURL: https://www.themoviedb.org/review/60d02f1a\n" +
+				"   In my top 5 of all\n   time favourite\n   movies.\n\n   Great story line and\n   a movie you can\n   watch over and over\n   again.\n\n",
+		},
+		{
+			name:     "NotFound",
+			args:     []string{"tv", "550"},
+			expError: review.ErrNotFound,
+		},
+		{
+			name:     "InvalidPage",
+			args:     []string{"movie", "550", "two"},
+			expError: strconv.ErrSyntax,
+		},
+		{
+			name:     "ZeroPage",
+			args:     []string{"movie", "550", "0"},
+			expError: errInvalidPage,
+		},
+		{
+			name:     "NegativePage",
+			args:     []string{"movie", "tt0137523", "-1"},
+			expError: errInvalidPage,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/movie/550/reviews" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					if page := r.URL.Query().Get("page"); page != "2" {
+						t.Errorf("Expected page 2, got %q", page)
+					}
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprintln(w, body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := reviewsAction(&out, url, tc.args, tc.ropts, outputOptions{})
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestResolveID(t *testing.T) {
+	found := map[string]string{
+		"imdb_id/tt0137523": `{"movie_results": [{"id": 550, "title": "Fight Club", "media_type": "movie"}], "person_results": [], "tv_results": []}`,
+		"imdb_id/tt0944947": `{"movie_results": [], "person_results": [], "tv_results": [{"id": 1399, "name": "Game of Thrones", "media_type": "tv"}]}`,
+		"imdb_id/nm0000093": `{"movie_results": [], "person_results": [{"id": 287, "name": "Brad Pitt", "media_type": "person"}], "tv_results": []}`,
+		"tvdb_id/81189":     `{"movie_results": [], "person_results": [], "tv_results": [{"id": 1396, "name": "Breaking Bad", "media_type": "tv"}]}`,
+	}
+
+	testCases := []struct {
+		name      string
+		mediaType string
+		ident     string
+		expType   string
+		expID     int
+		expError  error
+	}{
+		{name: "TMDB", mediaType: "movie", ident: "550", expType: "movie", expID: 550},
+		{name: "TMDBWithoutType", ident: "550", expError: errMediaTypeRequired},
+		{name: "TMDBPath", ident: "tv/1399", expType: "tv", expID: 1399},
+		{name: "TMDBPathMismatch", mediaType: "movie", ident: "tv/1399", expError: errMediaTypeMismatch},
+		{name: "TMDBCollectionPath", mediaType: "collection", ident: "collection/10", expType: "collection", expID: 10},
+		{name: "TMDBCompanyURL", mediaType: "company", ident: "https://www.themoviedb.org/company/420-marvel-studios", expType: "company", expID: 420},
+		{name: "TMDBNetworkMismatch", mediaType: "network", ident: "company/49", expError: errMediaTypeMismatch},
+		{name: "IMDbCollection", mediaType: "collection", ident: "tt0137523", expError: errNoExternalID},
+		{name: "TMDBURL", ident: "https://www.themoviedb.org/movie/550-fight-club", expType: "movie", expID: 550},
+		{name: "TMDBSeasonURL", mediaType: "tv", ident: "https://www.themoviedb.org/tv/1399-game-of-thrones/season/1", expType: "tv", expID: 1399},
+		{name: "IMDb", ident: "tt0137523", expType: "movie", expID: 550},
+		{name: "IMDbTv", mediaType: "tv", ident: "tt0944947", expType: "tv", expID: 1399},
+		{name: "IMDbWrongType", mediaType: "tv", ident: "tt0137523", expError: errNoMatch},
+		{name: "IMDbURL", ident: "https://www.imdb.com/title/tt0137523/?ref_=fn_al_tt_1", expType: "movie", expID: 550},
+		{name: "IMDbMobileURL", ident: "https://m.imdb.com/title/tt0944947/", expType: "tv", expID: 1399},
+		{name: "IMDbPerson", mediaType: "person", ident: "https://www.imdb.com/name/nm0000093/", expType: "person", expID: 287},
+		{name: "IMDbLocalizedURL", ident: "https://www.imdb.com/fr/title/tt0137523/", expType: "movie", expID: 550},
+		{name: "IMDbRegionalURL", mediaType: "person", ident: "https://www.imdb.com/pt-br/name/nm0000093/", expType: "person", expID: 287},
+		{name: "IMDbOtherURL", ident: "https://www.imdb.com/chart/top/", expError: strconv.ErrSyntax},
+		{name: "TVDB", ident: "tvdb:81189", expType: "tv", expID: 1396},
+		{name: "TVDBURL", ident: "https://thetvdb.com/?tab=series&id=81189", expType: "tv", expID: 1396},
+		{name: "TVDBSlugURL", ident: "https://thetvdb.com/series/breaking-bad", expError: errTVDBSlug},
+		{name: "Unknown", mediaType: "movie", ident: "tt0000000", expError: errNoMatch},
+		{name: "UnsupportedURL", ident: "https://www.rottentomatoes.com/m/fight_club", expError: strconv.ErrSyntax},
+		{name: "Invalid", mediaType: "movie", ident: "fight-club", expError: strconv.ErrSyntax},
+	}
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			id, ok := strings.CutPrefix(r.URL.Path, "/find/")
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if lang := r.URL.Query().Get("language"); lang != "fr-FR" {
+				t.Errorf("Expected language %q, got %q.", "fr-FR", lang)
+			}
+			body, ok := found[r.URL.Query().Get("external_source")+"/"+id]
+			if !ok {
+				body = `{"movie_results": [], "person_results": [], "tv_results": []}`
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mediaType, id, err := resolveID(url, tc.mediaType, tc.ident, "fr-FR")
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if mediaType != tc.expType || id != tc.expID {
+				t.Errorf("Expected %s %d, got %s %d.", tc.expType, tc.expID, mediaType, id)
+			}
+		})
+	}
+}
+
+func TestAddWatchlistActionIdentifier(t *testing.T) {
+	expBody := "{\"media_type\":\"tv\",\"media_id\":1399,\"watchlist\":true}\n"
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/account/null/watchlist" {
+				t.Errorf("Expected path %q, got %q", "/account/null/watchlist", r.URL.Path)
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Body.Close()
+
+			if string(body) != expBody {
+				t.Errorf("Expected body %q, got %q", expBody, string(body))
+			}
+
+			w.WriteHeader(testResp["resultsAddWatchlist"].Status)
+			fmt.Fprintln(w, testResp["resultsAddWatchlist"].Body)
+		})
+	defer cleanup()
+
+	var out bytes.Buffer
+
+	args := []string{"https://www.themoviedb.org/tv/1399-game-of-thrones", "yes"}
+	if err := addWatchlistAction(&out, url, args, outputOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %q", err)
+	}
+}
+
+func TestReleaseActions(t *testing.T) {
+	responses := map[string]string{
+		"/movie/550/release_dates": `{"id": 550, "results": [
+  {"iso_3166_1": "US", "release_dates": [
+    {"certification": "R", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "2000-06-06T00:00:00.000Z", "type": 4},
+    {"certification": "R", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1999-10-15T00:00:00.000Z", "type": 3}]},
+  {"iso_3166_1": "DE", "release_dates": [
+    {"certification": "18", "descriptors": [], "iso_639_1": "", "note": "Berlin", "release_date": "1999-11-11T00:00:00.000Z", "type": 3}]}]}`,
+		"/movie/11/release_dates": `{"id": 11, "results": [
+  {"iso_3166_1": "US", "release_dates": [
+    {"certification": "PG", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1977-05-25T00:00:00.000Z", "type": 3}]}]}`,
+		"/movie/500/release_dates": `{"id": 500, "results": [
+  {"iso_3166_1": "US", "release_dates": [
+    {"certification": "", "descriptors": [], "iso_639_1": "", "note": "Sundance", "release_date": "1992-01-21T00:00:00.000Z", "type": 1},
+    {"certification": "R", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1992-10-23T00:00:00.000Z", "type": 2}]}]}`,
+		"/movie/499/release_dates": `{"id": 499, "results": [
+  {"iso_3166_1": "FR", "release_dates": [
+    {"certification": "", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1962-04-11T00:00:00.000Z", "type": 3}]}]}`,
+		"/certification/movie/list": `{"certifications": {
+  "US": [
+    {"certification": "R", "meaning": "Under 17 requires accompanying parent or adult guardian.", "order": 4},
+    {"certification": "G", "meaning": "All ages admitted.", "order": 1},
+    {"certification": "PG-13", "meaning": "Some material may be inappropriate for children under 13.", "order": 3},
+    {"certification": "PG", "meaning": "Some material may not be suitable for children.", "order": 2}],
+  "DE": [{"certification": "18", "meaning": "No youth admitted.", "order": 5}]}}`,
+	}
+
+	testCases := []struct {
+		name     string
+		action   func(io.Writer, string) error
+		expError error
+		expOut   string
+	}{
+		{
+			name: "ReleaseDates",
+			action: func(out io.Writer, url string) error {
+				return releaseDatesAction(out, url, []string{"550"}, "", outputOptions{})
+			},
+			expOut: "Release dates for movie 550\n" +
+				"Region  Date        Type        Certification  Note\n" +
+				"DE      1999-11-11  Theatrical  18             Berlin\n" +
+				"US      1999-10-15  Theatrical  R              \n" +
+				"US      2000-06-06  Digital     R              \n",
+		},
+		{
+			name: "ReleaseDatesRegion",
+			action: func(out io.Writer, url string) error {
+				return releaseDatesAction(out, url, []string{"movie/500"}, "us", outputOptions{})
+			},
+			expOut: "Release dates for movie 500\n" +
+				"Region  Date        Type                  Certification  Note\n" +
+				"US      1992-01-21  Premiere              -              Sundance\n" +
+				"US      1992-10-23  Theatrical (limited)  R              \n",
+		},
+		{
+			name: "Certifications",
+			action: func(out io.Writer, url string) error {
+				return certificationsAction(out, url, []string{"movie"}, "US", outputOptions{})
+			},
+			expOut: "Certifications for movie in US\n" +
+				"Certification  Meaning\n" +
+				"G              All ages admitted.\n" +
+				"PG             Some material may not be suitable for children.\n" +
+				"PG-13          Some material may be inappropriate for children under 13.\n" +
+				"R              Under 17 requires accompanying parent or adult guardian.\n",
+		},
+		{
+			name: "WatchlistMaxCertification",
+			action: func(out io.Writer, url string) error {
+				return getWatchlistAction(out, url, []string{"movies"},
+					releaseOptions{region: "US", maxCertification: "pg-13"}, outputOptions{})
+			},
+			expOut: "Watchlist Movies:\n1. Title: Star Wars\nRelease Date: 1977-05-25\nTheatrical Release (US): 1977-05-25\nCertification: PG\n" +
+				"Genres: Adventure, Action, Science Fiction\nPoster: https://image.tmdb.org/t/p/original/6FfCtAuVAW8XJjZ7eWeLibRLWTw.jpg\n" +
+				"Popularity: 20.152500\nVote Count: 21056\nVote Average: 8.203000\n\n",
+		},
+		{
+			name: "WatchlistSortedRaw",
+			action: func(out io.Writer, url string) error {
+				var buf bytes.Buffer
+				err := getWatchlistAction(&buf, url, []string{"movies"},
+					releaseOptions{region: "US", sortBy: "release-date"}, outputOptions{format: "json"})
+
+				var resp account.WatchlistMoviesResponse
+				if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+					return err
+				}
+				for _, r := range resp.Results {
+					fmt.Fprintln(out, r.ID)
+				}
+				return err
+			},
+			expOut: "11\n500\n550\n499\n",
+		},
+		{
+			name: "WatchlistSortedDescending",
+			action: func(out io.Writer, url string) error {
+				var buf bytes.Buffer
+				err := getWatchlistAction(&buf, url, []string{"movies"},
+					releaseOptions{region: "US", sortBy: "release-date:desc"}, outputOptions{format: "json"})
+
+				var resp account.WatchlistMoviesResponse
+				if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+					return err
+				}
+				for _, r := range resp.Results {
+					fmt.Fprintln(out, r.ID)
+				}
+				return err
+			},
+			expOut: "550\n500\n11\n499\n",
+		},
+		{
+			name: "WatchlistDigitalAfter",
+			action: func(out io.Writer, url string) error {
+				var buf bytes.Buffer
+				err := getWatchlistAction(&buf, url, []string{"movies"},
+					releaseOptions{region: "US", releaseType: "digital", after: "2000-01-01"}, outputOptions{format: "json"})
+
+				var resp account.WatchlistMoviesResponse
+				if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+					return err
+				}
+				for _, r := range resp.Results {
+					fmt.Fprintln(out, r.ID)
+				}
+				return err
+			},
+			expOut: "550\n",
+		},
+		{
+			name: "UnknownCertification",
+			action: func(out io.Writer, url string) error {
+				return getWatchlistAction(out, url, []string{"movies"},
+					releaseOptions{region: "US", maxCertification: "U"}, outputOptions{})
+			},
+			expError: certification.ErrUnknownCertification,
+		},
+		{
+			name: "InvalidReleaseType",
+			action: func(out io.Writer, url string) error {
+				return getWatchlistAction(out, url, []string{"movies"},
+					releaseOptions{region: "US", releaseType: "physical"}, outputOptions{})
+			},
+			expError: movie.ErrInvalidReleaseType,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+
+					var resp struct {
+						Status int
+						Body   string
+					}
+					switch r.URL.Path {
+					case "/account/null/watchlist/movies":
+						resp = testResp["resultsWatchlistMovies"]
+					default:
+						body, ok := responses[r.URL.Path]
+						if !ok {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						resp.Status, resp.Body = http.StatusOK, body
+					}
+					w.WriteHeader(resp.Status)
+					fmt.Fprintln(w, resp.Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := tc.action(&out, url)
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestEachParallel(t *testing.T) {
+	var running, peak atomic.Int32
+
+	err := eachParallel(20, func(i int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			m := peak.Load()
+			if n <= m || peak.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		if i == 7 || i == 12 {
+			return fmt.Errorf("failed %d", i)
+		}
+		return nil
+	})
+
+	if err == nil || err.Error() != "failed 7" {
+		t.Errorf("Expected error %q, got %v.", "failed 7", err)
+	}
+
+	if peak.Load() > lookupConcurrency {
+		t.Errorf("Expected at most %d calls at once, got %d.", lookupConcurrency, peak.Load())
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	now := time.Date(2025, time.October, 19, 15, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		since    string
+		until    string
+		expStart string
+		expEnd   string
+		expError error
+	}{
+		{name: "Days", since: "7d", expStart: "2025-10-12", expEnd: "2025-10-19"},
+		{name: "Weeks", since: "2w", expStart: "2025-10-05", expEnd: "2025-10-19"},
+		{name: "Duration", since: "36h", expStart: "2025-10-18", expEnd: "2025-10-19"},
+		{name: "Dates", since: "2025-09-01", until: "2025-09-30", expStart: "2025-09-01", expEnd: "2025-09-30"},
+		{name: "Invalid", since: "last week", expError: errInvalidSince},
+		{name: "AfterUntil", since: "2025-10-01", until: "2025-09-30", expError: errInvalidSince},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, err := parsePeriod(tc.since, tc.until, now)
+
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %v.", tc.expError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if s, e := start.Format(time.DateOnly), end.Format(time.DateOnly); s != tc.expStart || e != tc.expEnd {
+				t.Errorf("Expected %s to %s, got %s to %s.", tc.expStart, tc.expEnd, s, e)
+			}
+		})
+	}
+}
+
+func TestChangesAction(t *testing.T) {
+	// Changes by path and start date
+	changes := map[string]string{
+		"/movie/550/changes 2025-09-01": `{"changes": [
+  {"key": "images", "items": [{"id": "a1", "action": "added", "time": "2025-09-03 10:00:00 UTC",
+    "value": {"poster": {"file_path": "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg", "iso_639_1": "en"}}}]},
+  {"key": "overview", "items": [{"id": "o1", "action": "updated", "time": "2025-09-10 08:00:00 UTC", "iso_639_1": "en",
+    "value": "A ticking-time-bomb insomniac and a slippery soap salesman channel primal male aggression.",
+    "original_value": "An insomniac office worker."}]}]}`,
+		"/movie/550/changes 2025-09-15": `{"changes": [
+  {"key": "images", "items": [{"id": "a2", "action": "deleted", "time": "2025-09-16 12:00:00 UTC",
+    "original_value": {"backdrop": {"file_path": "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg"}}}]}]}`,
+		"/movie/11/changes 2025-10-12": `{"changes": [
+  {"key": "release_dates", "items": [{"id": "r1", "action": "added", "time": "2025-10-13 09:00:00 UTC", "iso_3166_1": "US",
+    "value": {"type": 4, "release_date": "2025-10-31"}}]},
+  {"key": "images", "items": [{"id": "i1", "action": "added", "time": "2025-10-14 09:00:00 UTC"},
+    {"id": "i2", "action": "added", "time": "2025-10-15 09:00:00 UTC"}]}]}`,
+		"/tv/450/changes 2025-10-12": `{"changes": [
+  {"key": "overview", "items": [{"id": "t1", "action": "updated", "time": "2025-10-17 09:00:00 UTC", "value": "Jersey, 1940."}]}]}`,
+	}
+
+	day := func(s string) time.Time {
+		t, _ := time.Parse(time.DateOnly, s)
+		return t
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		copts    changesOptions
+		expEnds  []string
+		expError error
+		expOut   string
+	}{
+		{
+			name:    "SplitRange",
+			args:    []string{"movie", "550"},
+			copts:   changesOptions{start: day("2025-09-01"), end: day("2025-09-20")},
+			expEnds: []string{"2025-09-14", "2025-09-20"},
+			expOut: "Changes for movie 550 from 2025-09-01 to 2025-09-20\n" +
+				"Time                     Key       Action   Value\n" +
+				"2025-09-03 10:00:00 UTC  images    added    {\"poster\":{\"file_path\":\"/pB8BM7pdSp6B6Ih7QZ4DrQ3P…\n" +
+				"2025-09-16 12:00:00 UTC  images    deleted  {\"backdrop\":{\"file_path\":\"/hZkgoQYus5vegHoetLkCJz…\n" +
+				"2025-09-10 08:00:00 UTC  overview  updated  A ticking-time-bomb insomniac and a slippery soap…\n",
+		},
+		{
+			name:    "Keys",
+			args:    []string{"movie", "550"},
+			copts:   changesOptions{start: day("2025-09-01"), end: day("2025-09-14"), keys: []string{"overview"}},
+			expEnds: []string{"2025-09-14"},
+			expOut: "Changes for movie 550 from 2025-09-01 to 2025-09-14\n" +
+				"Time                     Key       Action   Value\n" +
+				"2025-09-10 08:00:00 UTC  overview  updated  A ticking-time-bomb insomniac and a slippery soap…\n",
+		},
+		{
+			name:     "InvalidMediaType",
+			args:     []string{"collection", "10"},
+			expError: errors.New("invalid <media_type> value"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var ends []string
+
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					ends = append(ends, r.URL.Query().Get("end_date"))
+					body, ok := changes[r.URL.Path+" "+r.URL.Query().Get("start_date")]
+					if !ok {
+						body = `{"changes": []}`
+					}
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprintln(w, body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := changesAction(&out, url, tc.args, tc.copts, outputOptions{})
+
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+
+				if err.Error() != tc.expError.Error() {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if strings.Join(ends, ",") != strings.Join(tc.expEnds, ",") {
+				t.Errorf("Expected requests ending %v, got %v.", tc.expEnds, ends)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("Watchlist", func(t *testing.T) {
+		url, cleanup := mockServer(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/account/null/watchlist/movies":
+					fmt.Fprintln(w, testResp["resultsWatchlistMovies"].Body)
+					return
+				case "/account/null/watchlist/tv":
+					fmt.Fprintln(w, testResp["resultsWatchlistTv"].Body)
+					return
+				}
+				body, ok := changes[r.URL.Path+" "+r.URL.Query().Get("start_date")]
+				if !ok {
+					body = `{"changes": []}`
+				}
+				fmt.Fprintln(w, body)
+			})
+		defer cleanup()
+
+		var out bytes.Buffer
+
+		copts := changesOptions{start: day("2025-10-12"), end: day("2025-10-19")}
+		if err := watchlistChangesAction(&out, url, nil, copts, outputOptions{}); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "Watchlist changes from 2025-10-12 to 2025-10-19\n" +
+			"Star Wars (movie 11): images (2), release_dates (1)\n" +
+			"Island at War (tv 450): overview (1)\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output %q, got %q.", expOut, out.String())
+		}
+
+		out.Reset()
+		copts.keys = []string{"cast"}
+		if err := watchlistChangesAction(&out, url, []string{"movies"}, copts, outputOptions{}); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut = "Watchlist changes from 2025-10-12 to 2025-10-19\nNo changes\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output %q, got %q.", expOut, out.String())
+		}
+	})
+}
+
+func TestMetadataActions(t *testing.T) {
+	bodies := map[string]string{
+		"/movie/550/keywords": `{"id": 550, "keywords": [{"id": 825, "name": "support group"},
+  {"id": 851, "name": "dual identity"}]}`,
+		"/movie/550/alternative_titles": `{"id": 550, "titles": [{"iso_3166_1": "FR", "title": "Le Club de combat", "type": ""}]}`,
+		"/movie/550/translations": `{"id": 550, "translations": [
+  {"iso_3166_1": "US", "iso_639_1": "en", "name": "English", "english_name": "English", "data": {"title": "Fight Club"}},
+  {"iso_3166_1": "FR", "iso_639_1": "fr", "name": "Français", "english_name": "French", "data": {"title": "Fight Club"}},
+  {"iso_3166_1": "DE", "iso_639_1": "de", "name": "Deutsch", "english_name": "German", "data": {"title": ""}}]}`,
+		"/tv/1399/keywords": `{"id": 1399, "results": [{"id": 6091, "name": "war"}]}`,
+		"/tv/1399/alternative_titles": `{"id": 1399, "results": [{"iso_3166_1": "FR", "title": "Le Trône de fer", "type": ""},
+  {"iso_3166_1": "US", "title": "GoT", "type": "short"}]}`,
+		"/tv/1399/translations": `{"id": 1399, "translations": [
+  {"iso_3166_1": "FR", "iso_639_1": "fr", "name": "Français", "english_name": "French", "data": {"name": "Le Trône de fer"}}]}`,
+	}
+
+	testCases := []struct {
+		name     string
+		action   func(io.Writer, string) error
+		expQuery string
+		expError error
+		expOut   string
+	}{
+		{
+			name: "MovieKeywords",
+			action: func(out io.Writer, url string) error {
+				return keywordsAction(out, url, "movie", []string{"550"}, outputOptions{})
+			},
+			expOut: "Keywords for movie 550\n1. support group\n2. dual identity\n",
+		},
+		{
+			name: "TvKeywords",
+			action: func(out io.Writer, url string) error {
+				return keywordsAction(out, url, "tv", []string{"1399"}, outputOptions{})
+			},
+			expOut: "Keywords for tv 1399\n1. war\n",
+		},
+		{
+			name: "MovieAltTitlesRegion",
+			action: func(out io.Writer, url string) error {
+				return altTitlesAction(out, url, "movie", []string{"550"}, "fr", outputOptions{})
+			},
+			expQuery: "country=FR",
+			expOut: "Alternative titles for movie 550\n" +
+				"Country  Title              Type\n" +
+				"FR       Le Club de combat  -\n",
+		},
+		{
+			name: "TvAltTitlesRegion",
+			action: func(out io.Writer, url string) error {
+				return altTitlesAction(out, url, "tv", []string{"1399"}, "US", outputOptions{})
+			},
+			expOut: "Alternative titles for tv 1399\n" +
+				"Country  Title  Type\n" +
+				"US       GoT    short\n",
+		},
+		{
+			name: "MovieTranslations",
+			action: func(out io.Writer, url string) error {
+				return translationsAction(out, url, "movie", []string{"550"}, outputOptions{language: "fr-FR"})
+			},
+			expOut: "Translations for movie 550\n" +
+				"Title (fr-FR): Fight Club\n" +
+				"Language  Name     Title\n" +
+				"en-US     English  Fight Club\n" +
+				"fr-FR     French   Fight Club\n" +
+				"de-DE     German   -\n",
+		},
+		{
+			name: "TvTranslations",
+			action: func(out io.Writer, url string) error {
+				return translationsAction(out, url, "tv", []string{"1399"}, outputOptions{language: "en-US"})
+			},
+			expOut: "Translations for tv 1399\n" +
+				"Language  Name    Title\n" +
+				"fr-FR     French  Le Trône de fer\n",
+		},
+		{
+			name: "InvalidID",
+			action: func(out io.Writer, url string) error {
+				return keywordsAction(out, url, "movie", []string{"abc"}, outputOptions{})
+			},
+			expError: strconv.ErrSyntax,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if tc.expQuery != "" && !strings.Contains(r.URL.RawQuery, tc.expQuery) {
+						t.Errorf("Expected query to contain %q, got %q.", tc.expQuery, r.URL.RawQuery)
+					}
+					body, ok := bodies[r.URL.Path]
+					if !ok {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					fmt.Fprintln(w, body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			err := tc.action(&out, url)
+
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestLocalizedTitles(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if serveReference(w, r) {
+				return
+			}
+			if lang := r.URL.Query().Get("language"); lang != "fr-FR" {
+				t.Errorf("Expected language %q, got %q.", "fr-FR", lang)
+			}
+			fmt.Fprintln(w, `{"page": 1, "results": [
+  {"id": 1, "title": "Le Bon, la Brute et le Truand", "original_title": "Il buono, il brutto, il cattivo"},
+  {"id": 2, "title": "", "original_title": "Cléo de 5 à 7"}], "total_pages": 1, "total_results": 2}`)
+		})
+	defer cleanup()
+
+	var out bytes.Buffer
+
+	opts := outputOptions{language: "fr-FR"}
+	if err := getWatchlistAction(&out, url, []string{"movies"}, releaseOptions{}, opts); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	for _, exp := range []string{"Title: Le Bon, la Brute et le Truand\n", "Title: Cléo de 5 à 7\n"} {
+		if !strings.Contains(out.String(), exp) {
+			t.Errorf("Expected output to contain %q, got %q.", exp, out.String())
+		}
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	testCases := []struct {
+		name   string
+		action func(out io.Writer, url string, opts outputOptions) error
+		// body is the response of TMDB, a page of one movie by default
+		body string
+	}{
+		{
+			name: "PersonDetails",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return personDetailsAction(out, url, []string{"7467"}, opts)
+			},
+		},
+		{
+			name: "PersonCredits",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				// The ratings shown by the text output are fetched in English
+				filter := creditsOptions{mediaType: "movie", sortBy: "date"}
+				opts.format = "json"
+				return personCreditsAction(out, url, []string{"7467"}, filter, opts)
+			},
+		},
+		{
+			name: "RatedEpisodes",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getRatedEpisodesAction(out, url, opts)
+			},
+		},
+		{
+			name: "Recommend",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return recommendAction(out, url, recommendOptions{minRating: 7}, opts)
+			},
+		},
+		{
+			name: "ListShow",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return listShowAction(out, url, []string{"8521773"}, opts)
+			},
+		},
+		{
+			name: "CollectionDetails",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return collectionDetailsAction(out, url, []string{"10"}, opts)
+			},
+		},
+		{
+			name: "CollectionCompletion",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return collectionCompletionAction(out, url, opts)
+			},
+		},
+		{
+			name: "Reviews",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return reviewsAction(out, url, []string{"movie", "550"}, reviewsOptions{}, opts)
+			},
+			body: `{"id": 550, "page": 1, "results": [{"id": "5b1c1", "author": "Cat Ellington"}], "total_pages": 1, "total_results": 1}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body := tc.body
+			if body == "" {
+				body = `{"id": 7467, "page": 1, "results": [{"id": 1, "title": "Shrek", "rating": 8}], "total_pages": 1, "total_results": 1}`
+			}
+
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					if lang := r.URL.Query().Get("language"); lang != "fr-FR" {
+						t.Errorf("Expected language %q for %s, got %q.", "fr-FR", r.URL.Path, lang)
+					}
+					fmt.Fprintln(w, body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			if err := tc.action(&out, url, outputOptions{language: "fr-FR"}); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+		})
+	}
+}
+
+func TestOutputFormats(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/tv/1399/account_states":
+				fmt.Fprintln(w, `{"id": 1399, "favorite": false, "rated": false, "watchlist": true}`)
+			case "/tv/2734/account_states":
+				fmt.Fprintln(w, `{"id": 2734, "favorite": true, "rated": {"value": 10.0}, "watchlist": true}`)
+			case "/account/null/favorite":
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"success": true, "status_code": 1, "status_message": "Success."}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	testCases := []struct {
+		format string
+		expOut string
+	}{
+		{
+			format: "table",
+			expOut: "id    favorite  rated         watchlist\n" +
+				"1399  false     false         true\n" +
+				"2734  true      {\"value\":10}  true\n",
+		},
+		{
+			format: "csv",
+			expOut: "id,favorite,rated,watchlist\n" +
+				"1399,false,false,true\n" +
+				"2734,true,\"{\"\"value\"\":10}\",true\n",
+		},
+		{
+			format: "tsv",
+			expOut: "id\tfavorite\trated\twatchlist\n" +
+				"1399\tfalse\tfalse\ttrue\n" +
+				"2734\ttrue\t{\"value\":10}\ttrue\n",
+		},
+		{
+			format: "jsonl",
+			expOut: "{\"id\":1399,\"favorite\":false,\"rated\":false,\"watchlist\":true}\n" +
+				"{\"id\":2734,\"favorite\":true,\"rated\":{\"value\":10},\"watchlist\":true}\n",
+		},
+		{
+			format: "yaml",
+			expOut: "- id: 1399\n  favorite: false\n  rated: false\n  watchlist: true\n" +
+				"- id: 2734\n  favorite: true\n  rated:\n    value: 10\n  watchlist: true\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			var out bytes.Buffer
+
+			err := stateAction(&out, url, []string{"tv", "1399", "2734"}, outputOptions{format: tc.format})
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("Mutation", func(t *testing.T) {
+		var out bytes.Buffer
+
+		err := addAction(&out, url, []string{"tv", "1399", "yes"}, outputOptions{format: "yaml"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "success: true\nstatus_code: 1\nstatus_message: Success.\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output %q, got %q.", expOut, out.String())
+		}
+	})
+}
+
+func TestGetOutputOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		output    string
+		raw       bool
+		expFormat string
+		expError  error
+	}{
+		{name: "Default", output: "text", expFormat: "text"},
+		{name: "Output", output: "csv", expFormat: "csv"},
+		{name: "RawAlias", output: "text", raw: true, expFormat: "json"},
+		{name: "Unknown", output: "xml", expError: output.ErrUnknownFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Set("output", tc.output)
+			defer viper.Set("output", nil)
+
+			cmd := &cobra.Command{}
+			cmd.Flags().Bool("raw", tc.raw, "")
+
+			opts, err := getOutputOptions(cmd)
+
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if opts.format != tc.expFormat {
+				t.Errorf("Expected format %q, got %q.", tc.expFormat, opts.format)
+			}
+		})
+	}
+}
+
+func TestReleaseSortOptions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		watchlist  bool
+		sortBy     string
+		expSortBy  string
+		expRelease string
+		expError   bool
+	}{
+		{name: "ListField", sortBy: "title:desc", expSortBy: "title:desc"},
+		{name: "ListReleaseDate", sortBy: "release-date", expError: true},
+		{name: "WatchlistField", watchlist: true, sortBy: "title", expSortBy: "title"},
+		{name: "WatchlistReleaseDate", watchlist: true, sortBy: "release-date", expRelease: "release-date"},
+		{name: "WatchlistReleaseDateDesc", watchlist: true, sortBy: "release-date:desc", expRelease: "release-date:desc"},
+		{name: "WatchlistInvalidOrder", watchlist: true, sortBy: "release-date:down", expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().StringSlice("columns", nil, "")
+			cmd.Flags().String("sort-by", tc.sortBy, "")
+			if tc.watchlist {
+				cmd.Flags().String("release-type", "", "")
+				cmd.Flags().String("released-after", "", "")
+				cmd.Flags().String("released-before", "", "")
+				cmd.Flags().String("max-certification", "", "")
+			}
+
+			opts, err := getOutputOptions(cmd)
+			var ropts releaseOptions
+			if err == nil && tc.watchlist {
+				ropts, err = getReleaseOptions(cmd)
+			}
+
+			if tc.expError {
+				if err == nil {
+					t.Fatalf("Expected an error for --sort-by %q, got none.", tc.sortBy)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if opts.sortBy != tc.expSortBy {
+				t.Errorf("Expected list sort %q, got %q.", tc.expSortBy, opts.sortBy)
+			}
+
+			if ropts.sortBy != tc.expRelease {
+				t.Errorf("Expected release sort %q, got %q.", tc.expRelease, ropts.sortBy)
+			}
+		})
+	}
+}
+
+func TestTemplateOutput(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if serveReference(w, r) {
+				return
+			}
+			fmt.Fprint(w, testResp["resultsWatchlistMovies"].Body)
+		})
+	defer cleanup()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "titles.tmpl")
+	if err := os.WriteFile(file, []byte(`{{range .Results}}{{.Title}}{{"\n"}}{{end}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		template string
+		expError bool
+		expOut   string
+	}{
+		{
+			name: "Helpers",
+			template: `{{range .Results}}{{truncate 10 .Title}} ({{date "Jan 2006" .ReleaseDate}}) ` +
+				`{{stars .VoteAverage}} {{join "/" .GenreIds}} {{image "poster" .PosterPath "w92"}}{{"\n"}}{{end}}`,
+			expOut: "Star Wars (May 1977) ★★★★☆ 12/28/878 https://image.tmdb.org/t/p/w92/6FfCtAuVAW8XJjZ7eWeLibRLWTw.jpg\n" +
+				"Fight Club (Oct 1999) ★★★★☆ 18 https://image.tmdb.org/t/p/w92/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg\n" +
+				"Reservoir… (Sep 1992) ★★★★☆ 80/53 https://image.tmdb.org/t/p/w92/xi8Iu6qyTfyZVDVy60raIOYJJmk.jpg\n" +
+				"Cléo from… (Apr 1962) ★★★★☆ 18 https://image.tmdb.org/t/p/w92/oelBStY4xpguaplRv15P3Za7Xsr.jpg\n",
+		},
+		{
+			name:     "File",
+			template: "@" + file,
+			expOut:   "Star Wars\nFight Club\nReservoir Dogs\nCléo from 5 to 7\n",
+		},
+		{
+			name:     "Invalid",
+			template: "{{range .Results}",
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, err := parseTemplate(url, "original", tc.template)
+			if tc.expError {
+				if err == nil {
+					t.Fatal("Expected error, got no error.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			var out bytes.Buffer
+
+			opts := outputOptions{template: tmpl, language: "en-US"}
+			if err := getWatchlistAction(&out, url, []string{"movies"}, releaseOptions{}, opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestColumnsActions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		action   func(io.Writer, string, outputOptions) error
+		resp     string
+		opts     outputOptions
+		expError bool
+		expOut   string
+	}{
+		{
+			name: "WatchlistSorted",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getWatchlistAction(out, url, []string{"movies"}, releaseOptions{}, opts)
+			},
+			resp: "resultsWatchlistMovies",
+			opts: outputOptions{columns: []string{"title", "year", "vote_average", "genres"}, sortBy: "vote_average:desc"},
+			expOut: "Title             Year  Vote Average  Genres\n" +
+				"Fight Club        1999  8.44          Drama\n" +
+				"Star Wars         1977  8.20          Adventure, Action, Science Fiction\n" +
+				"Reservoir Dogs    1992  8.12          Crime, Thriller\n" +
+				"Cléo from 5 to 7  1962  7.70          Drama\n",
+		},
+		{
+			name: "FavoriteCsv",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getAction(out, url, []string{"movies"}, opts)
+			},
+			resp:   "resultsFavMovies",
+			opts:   outputOptions{format: "csv", columns: []string{"id", "title", "rating"}, sortBy: "title"},
+			expOut: "id,title,rating\n555,Absolut,\n1165067,Cosmic Chaos,\n",
+		},
+		{
+			name: "Rated",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getRatedAction(out, url, []string{"movies"}, opts)
+			},
+			resp: "resultsGetRated",
+			opts: outputOptions{columns: []string{"title", "rating", "vote_average"}, sortBy: "vote_average"},
+			expOut: "Title              Rating  Vote Average\n" +
+				"A Minecraft Movie  8.0     6.10\n" +
+				"The Wild Robot     8.0     8.33\n",
+		},
+		{
+			name: "RatedEpisodesSorted",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getRatedEpisodesAction(out, url, opts)
+			},
+			resp: "resultsGetRatedEpisodes",
+			opts: outputOptions{columns: []string{"title", "date", "vote_average"}, sortBy: "vote_average"},
+			expOut: "Title            Date        Vote Average\n" +
+				"The Iron Throne  2019-05-19  4.57\n" +
+				"The Long Night   2019-04-28  6.87\n",
+		},
+		{
+			name: "RatedEpisodesCsv",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return getRatedEpisodesAction(out, url, opts)
+			},
+			resp: "resultsGetRatedEpisodes",
+			opts: outputOptions{format: "csv"},
+			expOut: "id,title,year,date,overview,poster,vote_average,vote_count,rating\n" +
+				"1551827,The Long Night,2019,2019-04-28,The Night King and his army have arrived at Winterfell and the great battle begins. Arya looks to prove her worth as a fighter.,https://image.tmdb.org/t/p/original/mFtHbZenI5rRPqC5OFafoVmjEjq.jpg,6.873,308,4\n" +
+				"1551830,The Iron Throne,2019,2019-05-19,\"In the aftermath of the devastating attack on King's Landing, Daenerys must face the survivors.\",https://image.tmdb.org/t/p/original/zBi2O5EJfgTS6Ae0HdAYLm9o2nf.jpg,4.574,343,2\n",
+		},
+		{
+			name: "ListSortedByTitle",
+			action: func(out io.Writer, url string, opts outputOptions) error {
+				return listShowAction(out, url, []string{"8527130"}, opts)
+			},
+			resp: "resultsListDetails",
+			opts: outputOptions{columns: []string{"id", "title", "genres"}, sortBy: "title"},
+			expOut: "ID   Title       Genres\n" +
+				"550  Fight Club  Drama\n" +
+				"11   Star Wars   Adventure, Action, Science Fiction\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					fmt.Fprint(w, testResp[tc.resp].Body)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			tc.opts.language = "en-US"
+			if err := tc.action(&out, url, tc.opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, c := range []struct {
+			columns []string
+			sortBy  string
+		}{
+			{[]string{"title", "director"}, ""},
+			{nil, "budget"},
+			{nil, "title:up"},
+		} {
+			if err := validateColumns(c.columns, c.sortBy); err == nil {
+				t.Errorf("Expected error for %v %q, got no error.", c.columns, c.sortBy)
+			}
+		}
+	})
+}
+
+func TestQueryOutput(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if serveReference(w, r) {
+				return
+			}
+			// A field the typed models don't carry
+			body := strings.Replace(testResp["resultsWatchlistMovies"].Body,
+				`"page": 1,`, `"page": 1, "extra": "kept",`, 1)
+			fmt.Fprint(w, body)
+		})
+	defer cleanup()
+
+	testCases := []struct {
+		name     string
+		query    string
+		source   string
+		format   string
+		genres   []string
+		expError bool
+		expOut   string
+	}{
+		{
+			name:   "SelectTitles",
+			query:  ".results[] | select(.vote_average > 8.15) | .title",
+			expOut: "Star Wars\nFight Club\n",
+		},
+		{
+			name:   "Construct",
+			query:  `.results | sort_by(.release_date) | first | {title, year: .release_date[0:4], genres: (.genre_ids | length)}`,
+			format: "json",
+			expOut: "{\n   \"title\": \"Cléo from 5 to 7\",\n   \"year\": \"1962\",\n   \"genres\": 1\n}\n",
+		},
+		{
+			name:   "Csv",
+			query:  `[.results[] | {id, title}] | .[1:3]`,
+			format: "csv",
+			expOut: "id,title\n550,Fight Club\n500,Reservoir Dogs\n",
+		},
+		{
+			name:   "Arithmetic",
+			query:  `[.results[].vote_count] | length, (.[0] + .[1]), (map(. > 10000) | join(","))`,
+			expOut: "4\n51198\ntrue,true,true,false\n",
+		},
+		{
+			name:   "TypedIsFiltered",
+			query:  `.results | map(.title) | join(", ")`,
+			genres: []string{"crime"},
+			expOut: "Reservoir Dogs\n",
+		},
+		{
+			name:   "ServerIsUnfiltered",
+			query:  `.extra, (.results | length)`,
+			source: "server",
+			genres: []string{"crime"},
+			expOut: "kept\n4\n",
+		},
+		{
+			name:   "TypedHasNoExtra",
+			query:  `has("extra")`,
+			expOut: "false\n",
+		},
+		{
+			name:     "Invalid",
+			query:    ".results[] | select(.title ==",
+			expError: true,
+		},
+		{
+			name:     "RuntimeError",
+			query:    ".results.title",
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := output.ParseQuery(tc.query)
+			if err == nil {
+				client.Record()
+
+				var out bytes.Buffer
+
+				opts := outputOptions{format: tc.format, query: q, querySource: tc.source, language: "en-US", genres: tc.genres}
+				err = getWatchlistAction(&out, url, []string{"movies"}, releaseOptions{}, opts)
+
+				if err == nil && tc.expOut != out.String() {
+					t.Errorf("Expected output %q, got %q.", tc.expOut, out.String())
+				}
+			}
+
+			if tc.expError && err == nil {
+				t.Fatal("Expected error, got no error.")
+			}
+			if !tc.expError && err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+		})
+	}
+}
+
+func TestTerminalTables(t *testing.T) {
+	wide := `{"page": 1, "results": [
+  {"id": 129, "title": "千と千尋の神隠し", "release_date": "2001-07-20", "genre_ids": [16, 10751, 14], "vote_average": 8.5, "vote_count": 17000, "popularity": 20.5},
+  {"id": 1, "title": "Emoji 👩‍💻 movie", "release_date": "2020-01-01", "genre_ids": [35], "vote_average": 5, "vote_count": 3, "popularity": 1},
+  {"id": 2, "title": "A rather long title that cannot fit in a narrow terminal", "release_date": "1999-12-31", "genre_ids": [18], "vote_average": 7.25, "vote_count": 1200, "popularity": 3.5}],
+  "total_pages": 1, "total_results": 3}`
+
+	testCases := []struct {
+		name   string
+		opts   outputOptions
+		expOut string
+	}{
+		{
+			name: "Truncated",
+			opts: outputOptions{terminal: true, width: 60, columns: []string{"title", "year", "genres", "vote_average"}},
+			expOut: "Title                Year  Genres               Vote Average\n" +
+				"千と千尋の神隠し     2001  Animation, Family,…  8.50\n" +
+				"Emoji 👩‍💻 movie       2020  Comedy               5.00\n" +
+				"A rather long titl…  1999  Drama                7.25\n",
+		},
+		{
+			name: "Wrapped",
+			opts: outputOptions{terminal: true, width: 40, wrap: true, columns: []string{"title", "vote_count"}},
+			expOut: "Title                         Vote Count\n" +
+				"千と千尋の神隠し              17000\n" +
+				"Emoji 👩‍💻 movie                3\n" +
+				"A rather long title that      1200\n" +
+				"cannot fit in a narrow\n" +
+				"terminal\n",
+		},
+		{
+			name: "French",
+			opts: outputOptions{terminal: true, width: 60, locale: i18n.French, columns: []string{"title", "date", "vote_average"}},
+			expOut: "Titre                               Date        Note moyenne\n" +
+				"千と千尋の神隠し                    20/07/2001  8,50\n" +
+				"Emoji 👩‍💻 movie                      01/01/2020  5,00\n" +
+				"A rather long title that cannot f…  31/12/1999  7,25\n",
+		},
+		{
+			name: "DefaultColumns",
+			opts: outputOptions{terminal: true, width: 200},
+			expOut: "Title                                                     Date        Genres                      Popularity  Vote Count  Vote Average\n" +
+				"千と千尋の神隠し                                          2001-07-20  Animation, Family, Fantasy  20.50       17000       8.50\n" +
+				"Emoji 👩‍💻 movie                                            2020-01-01  Comedy                      1.00        3           5.00\n" +
+				"A rather long title that cannot fit in a narrow terminal  1999-12-31  Drama                       3.50        1200        7.25\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					fmt.Fprint(w, wide)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			tc.opts.language = "en-US"
+			if err := getAction(&out, url, []string{"movies"}, tc.opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output:\n%s\ngot:\n%s", tc.expOut, out.String())
+			}
+
+			for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+				if w := output.Width(line); w > tc.opts.width {
+					t.Errorf("Expected lines of at most %d cells, got %d: %q", tc.opts.width, w, line)
+				}
+			}
+		})
+	}
+}
+
+func TestColorOutput(t *testing.T) {
+	rated := `{"page": 1, "results": [
+  {"id": 550, "title": "Fight Club", "release_date": "1999-10-15", "vote_average": 8.4, "rating": 9},
+  {"id": 11, "title": "Star Wars", "release_date": "1977-05-25", "vote_average": 6.1, "rating": 6},
+  {"id": 2, "title": "Dud", "release_date": "2001-01-01", "vote_average": 3.2, "rating": 1}],
+  "total_pages": 1, "total_results": 3}`
+
+	blue, err := output.ParseTheme("heading=bold+blue, meta=none")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name   string
+		opts   outputOptions
+		expOut string
+	}{
+		{
+			name: "Colored",
+			opts: outputOptions{color: true, theme: output.DefaultTheme, columns: []string{"title", "date", "vote_average", "rating"}},
+			expOut: "\x1b[1mTitle\x1b[0m       \x1b[1mDate\x1b[0m        \x1b[1mVote Average\x1b[0m  \x1b[1mRating\x1b[0m\n" +
+				"Fight Club  \x1b[2m1999-10-15\x1b[0m  \x1b[32m8.40\x1b[0m          \x1b[33m★★★★★ 9.0\x1b[0m\n" +
+				"Star Wars   \x1b[2m1977-05-25\x1b[0m  \x1b[33m6.10\x1b[0m          \x1b[33m★★★☆☆ 6.0\x1b[0m\n" +
+				"Dud         \x1b[2m2001-01-01\x1b[0m  \x1b[31m3.20\x1b[0m          \x1b[33m★☆☆☆☆ 1.0\x1b[0m\n",
+		},
+		{
+			name: "Theme",
+			opts: outputOptions{color: true, theme: blue, columns: []string{"title", "date"}},
+			expOut: "\x1b[1;34mTitle\x1b[0m       \x1b[1;34mDate\x1b[0m\n" +
+				"Fight Club  1999-10-15\n" +
+				"Star Wars   1977-05-25\n" +
+				"Dud         2001-01-01\n",
+		},
+		{
+			name: "NoColor",
+			opts: outputOptions{columns: []string{"title", "date", "vote_average", "rating"}},
+			expOut: "Title       Date        Vote Average  Rating\n" +
+				"Fight Club  1999-10-15  8.40          9.0\n" +
+				"Star Wars   1977-05-25  6.10          6.0\n" +
+				"Dud         2001-01-01  3.20          1.0\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					fmt.Fprint(w, rated)
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			tc.opts.terminal = true
+			tc.opts.width = 120
+			tc.opts.language = "en-US"
+			if err := getRatedAction(&out, url, []string{"movies"}, tc.opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output:\n%q\ngot:\n%q", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("ShortRow", func(t *testing.T) {
+		var out bytes.Buffer
+
+		table := &output.Table{
+			Header: []string{"Title", "Date", "Vote Average"},
+			Kinds:  []output.Kind{output.Plain, output.Meta, output.Vote},
+			Rows:   [][]string{{"Fight Club", "1999-10-15", "8.40"}, {"Dud"}},
+		}
+		if err := table.Render(&out, output.Options{Color: true, Theme: output.DefaultTheme}); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "\x1b[1mTitle\x1b[0m       \x1b[1mDate\x1b[0m        \x1b[1mVote Average\x1b[0m\n" +
+			"Fight Club  \x1b[2m1999-10-15\x1b[0m  \x1b[32m8.40\x1b[0m\n" +
+			"Dud\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output:\n%q\ngot:\n%q", expOut, out.String())
+		}
+	})
+}
+
+func TestColorOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		color    string
+		noColor  string
+		theme    string
+		expColor bool
+		expError bool
+	}{
+		{name: "Auto", color: "auto"},
+		{name: "Always", color: "always", expColor: true},
+		{name: "AlwaysOverridesNoColor", color: "always", noColor: "1", expColor: true},
+		{name: "Never", color: "never"},
+		{name: "Invalid", color: "rainbow", expError: true},
+		{name: "InvalidTheme", color: "always", theme: "heading=sparkly", expError: true},
+		{name: "InvalidElement", color: "always", theme: "footer=bold", expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Set("output", "text")
+			viper.Set("color", tc.color)
+			viper.Set("theme", tc.theme)
+			defer func() {
+				viper.Set("output", nil)
+				viper.Set("color", nil)
+				viper.Set("theme", nil)
+			}()
+			t.Setenv("NO_COLOR", tc.noColor)
+
+			opts, err := getOutputOptions(&cobra.Command{})
+
+			if tc.expError {
+				if err == nil {
+					t.Fatal("Expected error, got nil.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if opts.color != tc.expColor {
+				t.Errorf("Expected color %t, got %t.", tc.expColor, opts.color)
+			}
+		})
+	}
+}
+
+func TestRawOutput(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"page": 1, "results": [{"id": 550, "title": "Fight Club", "vote_average": 8.433, "x_extra": true}], "total_pages": 2, "total_results": 2}`,
+		"2": `{"page":2,"results":[{"id":11,"title":"Star Wars","vote_average":8.2e0}],"total_pages":2,"total_results":2}`,
+	}
+
+	testCases := []struct {
+		name   string
+		opts   outputOptions
+		expOut string
+	}{
+		{
+			name:   "PerPage",
+			opts:   outputOptions{format: "json", raw: true},
+			expOut: pages["1"] + "\n" + pages["2"],
+		},
+		{
+			name:   "Merged",
+			opts:   outputOptions{format: "json", raw: true, mergePages: true},
+			expOut: "[" + pages["1"] + "," + pages["2"] + "]",
+		},
+		{
+			name: "Pretty",
+			opts: outputOptions{format: "json", raw: true, pretty: true},
+			expOut: `{
+   "page": 1,
+   "results": [
+      {
+         "id": 550,
+         "title": "Fight Club",
+         "vote_average": 8.433,
+         "x_extra": true
+      }
+   ],
+   "total_pages": 2,
+   "total_results": 2
+}
+{
+   "page": 2,
+   "results": [
+      {
+         "id": 11,
+         "title": "Star Wars",
+         "vote_average": 8.2e0
+      }
+   ],
+   "total_pages": 2,
+   "total_results": 2
+}`,
+		},
+		{
+			name: "Headers",
+			opts: outputOptions{format: "json", raw: true, includeHeaders: true, mergePages: true},
+			expOut: "HTTP/1.1 200 OK\nContent-Length: 138\nContent-Type: application/json\nX-Page: 1\n\n" +
+				"HTTP/1.1 200 OK\nContent-Length: 107\nContent-Type: application/json\nX-Page: 2\n\n" +
+				"[" + pages["1"] + "," + pages["2"] + "]",
+		},
+		{
+			name: "HeadersPerPage",
+			opts: outputOptions{format: "json", raw: true, includeHeaders: true},
+			expOut: "HTTP/1.1 200 OK\nContent-Length: 138\nContent-Type: application/json\nX-Page: 1\n\n" +
+				pages["1"] + "\n" +
+				"HTTP/1.1 200 OK\nContent-Length: 107\nContent-Type: application/json\nX-Page: 2\n\n" +
+				pages["2"],
+		},
+		{
+			name:   "Typed",
+			opts:   outputOptions{format: "csv", columns: []string{"id", "title"}},
+			expOut: "id,title\n550,Fight Club\n11,Star Wars\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+					page := r.URL.Query().Get("page")
+					w.Header()["Date"] = nil
+					w.Header().Set("Content-Type", "application/json")
+					w.Header().Set("X-Page", page)
+					fmt.Fprint(w, pages[page])
+				})
+			defer cleanup()
+
+			var out bytes.Buffer
+
+			client.Record()
+			tc.opts.language = "en-US"
+			if err := getAction(&out, url, []string{"movies"}, tc.opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output:\n%s\ngot:\n%s", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("Genres", func(t *testing.T) {
+		url, cleanup := mockServer(
+			func(w http.ResponseWriter, r *http.Request) {
+				if !serveReference(w, r) {
+					t.Errorf("Unexpected path %q", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+		defer cleanup()
+
+		// The cached genres are fetched again for --raw
+		cache.Bypass(true)
+		defer cache.Bypass(false)
+
+		var out bytes.Buffer
+
+		client.Record()
+		if err := genreListAction(&out, url, []string{"tv"}, outputOptions{format: "json", raw: true}); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		if exp := referenceResp["/genre/tv/list"] + "\n"; exp != out.String() {
+			t.Errorf("Expected output:\n%s\ngot:\n%s", exp, out.String())
+		}
+	})
+
+	t.Run("ExcludeRated", func(t *testing.T) {
+		credits := testResp["resultsPersonCredits"].Body
+
+		url, cleanup := mockServer(
+			func(w http.ResponseWriter, r *http.Request) {
+				if serveReference(w, r) {
+					return
+				}
+				switch r.URL.Path {
+				case "/person/7467/combined_credits":
+					fmt.Fprint(w, credits)
+				case "/account/null/rated/movies":
+					fmt.Fprint(w, `{"page":1,"results":[{"id":550,"title":"Fight Club","rating":9}],"total_pages":1,"total_results":1}`)
+				case "/account/null/rated/tv":
+					fmt.Fprint(w, `{"page":1,"results":[],"total_pages":1,"total_results":0}`)
+				default:
+					t.Errorf("Unexpected path %q", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+		defer cleanup()
+
+		// --raw fetches the rated titles again rather than using the cache
+		cache.Bypass(true)
+		defer cache.Bypass(false)
+
+		var out bytes.Buffer
+
+		client.Record()
+		filter := creditsOptions{mediaType: "combined", sortBy: "date", excludeRated: true}
+		if err := personCreditsAction(&out, url, []string{"7467"}, filter, outputOptions{format: "json", raw: true}); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		if credits != out.String() {
+			t.Errorf("Expected output:\n%s\ngot:\n%s", credits, out.String())
+		}
+	})
+}
+
+func TestPager(t *testing.T) {
+	testCases := []struct {
+		name     string
+		command  string
+		lines    int
+		quit     bool
+		expError bool
+		expOut   string
+	}{
+		{name: "Short", command: "sed s/^/>/", lines: 3, expOut: "1\n2\n3\n"},
+		{name: "Long", command: "sed s/^/>/", lines: 6, expOut: ">1\n>2\n>3\n>4\n>5\n>6\n"},
+		{name: "Cat", command: "cat", lines: 6, expOut: "1\n2\n3\n4\n5\n6\n"},
+		{name: "Missing", command: "tmdbcli-missing-pager -R", lines: 6, expOut: "1\n2\n3\n4\n5\n6\n"},
+		{name: "Quit", command: "head -n 1", lines: 100000, quit: true, expOut: "1\n"},
+		{name: "Failing", command: "false", lines: 100000, quit: true, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			p := &pager{command: tc.command, height: 5, out: &out}
+			for i := 1; i <= tc.lines; i++ {
+				if _, err := fmt.Fprintf(p, "%d\n", i); err != nil {
+					// Leaving the pager stops the output
+					if tc.quit && errors.Is(err, errPagerQuit) {
+						break
+					}
+					t.Fatalf("Expected no error, got %q.", err)
+				}
+			}
+
+			err := p.Close()
+			if tc.expError {
+				if err == nil {
+					t.Fatal("Expected the pager error, got no error.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output:\n%s\ngot:\n%s", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+func TestPageOutput(t *testing.T) {
+	testCases := []struct {
+		name     string
+		terminal bool
+		output   string
+		template string
+		raw      bool
+		exp      bool
+	}{
+		{name: "Terminal", terminal: true, output: "text", exp: true},
+		{name: "Table", terminal: true, output: "table"},
+		{name: "Template", terminal: true, output: "text", template: "{{.}}"},
+		{name: "Raw", terminal: true, output: "text", raw: true},
+		{name: "Redirected", output: "text"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Set("output", tc.output)
+			viper.Set("template", tc.template)
+			defer func() {
+				viper.Set("output", nil)
+				viper.Set("template", nil)
+			}()
+
+			cmd := &cobra.Command{}
+			cmd.Flags().Bool("raw", tc.raw, "")
+
+			if got := pageOutput(cmd, tc.terminal); got != tc.exp {
+				t.Errorf("Expected paging %t, got %t.", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestStartPager(t *testing.T) {
+	testCases := []struct {
+		name       string
+		noPager    bool
+		pager      string
+		envPager   string
+		expCommand string
+	}{
+		{name: "Default", expCommand: defaultPager},
+		{name: "PagerEnv", envPager: "more", expCommand: "more"},
+		{name: "Config", pager: "most -s", envPager: "more", expCommand: "most -s"},
+		{name: "NoPager", noPager: true, pager: "most"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Set("no-pager", tc.noPager)
+			viper.Set("pager", tc.pager)
+			defer func() {
+				viper.Set("no-pager", nil)
+				viper.Set("pager", nil)
+			}()
+			t.Setenv("PAGER", tc.envPager)
+
+			startPager()
+			p, ok := stdout.(*pager)
+			if err := stopPager(); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expCommand == "" {
+				if ok {
+					t.Fatalf("Expected no pager, got %q.", p.command)
+				}
+				return
+			}
+
+			if !ok {
+				t.Fatal("Expected the pager to be started.")
+			}
+			if p.command != tc.expCommand {
+				t.Errorf("Expected pager %q, got %q.", tc.expCommand, p.command)
+			}
+		})
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe to read while the command writes it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// failingWriter fails once max bytes have been written, like a closed pipe
+type failingWriter struct {
+	max int
+	n   int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n+len(p) > w.max {
+		return 0, syscall.EPIPE
+	}
+	w.n += len(p)
+	return len(p), nil
+}
+
+func TestStreamingOutput(t *testing.T) {
+	titles := []string{"Fight Club", "Star Wars", "Reservoir Dogs", "Absolut", "Cosmic Chaos"}
+
+	page := func(n int) string {
+		extra := ""
+		if n > 1 {
+			extra = `, "extra": true`
+		}
+		return fmt.Sprintf(`{"page": %d, "results": [{"id": %d, "title": %q, "genre_ids": [18]%s}], "total_pages": %d, "total_results": %d}`,
+			n, n, titles[n-1], extra, len(titles), len(titles))
+	}
+
+	testCases := []struct {
+		name   string
+		opts   outputOptions
+		expOut string
+	}{
+		{
+			name: "Text",
+			opts: outputOptions{},
+			expOut: "Favorite Movies:\n" +
+				"1. Title: Fight Club\nRelease Date: \nGenres: Drama\nPoster: \nPopularity: 0.00\nVote Count: 0\nVote Average: 0.00\n\n" +
+				"2. Title: Star Wars\nRelease Date: \nGenres: Drama\nPoster: \nPopularity: 0.00\nVote Count: 0\nVote Average: 0.00\n\n" +
+				"3. Title: Reservoir Dogs\nRelease Date: \nGenres: Drama\nPoster: \nPopularity: 0.00\nVote Count: 0\nVote Average: 0.00\n\n" +
+				"4. Title: Absolut\nRelease Date: \nGenres: Drama\nPoster: \nPopularity: 0.00\nVote Count: 0\nVote Average: 0.00\n\n" +
+				"5. Title: Cosmic Chaos\nRelease Date: \nGenres: Drama\nPoster: \nPopularity: 0.00\nVote Count: 0\nVote Average: 0.00\n\n",
+		},
+		{
+			name:   "Table",
+			opts:   outputOptions{terminal: true, columns: []string{"id", "title"}},
+			expOut: "ID  Title\n1   Fight Club\n2   Star Wars\n3   Reservoir Dogs\n4   Absolut\n5   Cosmic Chaos\n",
+		},
+		{
+			name:   "CSVColumns",
+			opts:   outputOptions{format: "csv", columns: []string{"id", "title"}},
+			expOut: "id,title\n1,Fight Club\n2,Star Wars\n3,Reservoir Dogs\n4,Absolut\n5,Cosmic Chaos\n",
+		},
+		{
+			// Every field, the same whether the pages are streamed or not
+			name: "StableHeader",
+			opts: outputOptions{format: "tsv"},
+			expOut: "id\ttitle\tyear\tdate\tgenres\toverview\tposter\tpopularity\tvote_average\tvote_count\trating\n" +
+				"1\tFight Club\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"2\tStar Wars\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"3\tReservoir Dogs\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"4\tAbsolut\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"5\tCosmic Chaos\t\t\tDrama\t\t\t0\t0\t0\t\n",
+		},
+		{
+			name: "SortedHeader",
+			opts: outputOptions{format: "tsv", sortBy: "title"},
+			expOut: "id\ttitle\tyear\tdate\tgenres\toverview\tposter\tpopularity\tvote_average\tvote_count\trating\n" +
+				"4\tAbsolut\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"5\tCosmic Chaos\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"1\tFight Club\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"3\tReservoir Dogs\t\t\tDrama\t\t\t0\t0\t0\t\n" +
+				"2\tStar Wars\t\t\tDrama\t\t\t0\t0\t0\t\n",
+		},
+		{
+			name:   "Sorted",
+			opts:   outputOptions{format: "jsonl", columns: []string{"title"}, sortBy: "title"},
+			expOut: "{\"title\":\"Absolut\"}\n{\"title\":\"Cosmic Chaos\"}\n{\"title\":\"Fight Club\"}\n{\"title\":\"Reservoir Dogs\"}\n{\"title\":\"Star Wars\"}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out syncBuffer
+
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if serveReference(w, r) {
+						return
+					}
+
+					n, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+					// The first page is printed by the time the third one
+					// is requested, unless the results have to be sorted
+					if n == 3 && tc.opts.streamable() && !strings.Contains(out.String(), "Fight Club") {
+						t.Error("Expected the first page to be printed before the third one is fetched.")
+					}
+
+					fmt.Fprint(w, page(n))
+				})
+			defer cleanup()
+
+			tc.opts.language = "en-US"
+			if err := getAction(&out, url, []string{"movies"}, tc.opts); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out.String() {
+				t.Errorf("Expected output:\n%s\ngot:\n%s", tc.expOut, out.String())
+			}
+		})
+	}
+
+	t.Run("ClosedOutput", func(t *testing.T) {
+		var requested atomic.Int32
+
+		url, cleanup := mockServer(
+			func(w http.ResponseWriter, r *http.Request) {
+				if serveReference(w, r) {
+					return
+				}
+				n, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				requested.Add(1)
+				fmt.Fprint(w, page(n))
+			})
+		defer cleanup()
+
+		opts := outputOptions{format: "jsonl", language: "en-US"}
+		err := getAction(&failingWriter{max: 100}, url, []string{"movies"}, opts)
+		if !errors.Is(err, syscall.EPIPE) {
+			t.Fatalf("Expected error %q, got %q.", syscall.EPIPE, err)
+		}
+
+		// The page following the failing one may have been requested already
+		if n := requested.Load(); n > 3 {
+			t.Errorf("Expected the requests to stop, got %d pages requested.", n)
+		}
+	})
+}
+
+func TestMessageCatalog(t *testing.T) {
+	t.Run("Printer", func(t *testing.T) {
+		resp := &account.FavoriteMoviesResponse{
+			Page: 1,
+			Results: []account.FavoriteMovie{
+				{ID: 550, Title: "Fight Club", ReleaseDate: "1999-10-15", GenreIds: []int{18},
+					Popularity: 73.4, VoteCount: 30000, VoteAverage: 8.433},
+			},
+		}
+		genres := genre.Names{18: "Drame"}
+
+		var out bytes.Buffer
+		if err := printFavMovies(&out, resp, genres, 0, i18n.French); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "Films favoris :\n" +
+			"1. Titre : Fight Club\n" +
+			"Date de sortie : 15/10/1999\n" +
+			"Genres : Drame\n" +
+			"Affiche : \n" +
+			"Popularité : 73,40\n" +
+			"Nombre de votes : 30000\n" +
+			"Note moyenne : 8,43\n\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output:\n%s\ngot:\n%s", expOut, out.String())
+		}
+	})
+
+	t.Run("PersonDetails", func(t *testing.T) {
+		resp := &person.DetailsResponse{ID: 287, Name: "Brad Pitt", KnownForDepartment: "Acting",
+			Birthday: "1963-12-18", PlaceOfBirth: "Shawnee, Oklahoma, USA", Popularity: 10.5}
+
+		var out bytes.Buffer
+		if err := printPersonDetails(&out, resp, i18n.French); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "Détails de la personne 287\n" +
+			"Nom : Brad Pitt\n" +
+			"Connu pour : Acting\n" +
+			"Date de naissance : 18/12/1963\n" +
+			"Lieu de naissance : Shawnee, Oklahoma, USA\n" +
+			"Popularité : 10,50\n" +
+			"Photo : \n" +
+			"Biographie : \n"
+		if expOut != out.String() {
+			t.Errorf("Expected output:\n%s\ngot:\n%s", expOut, out.String())
+		}
+	})
+
+	t.Run("ReleaseLabel", func(t *testing.T) {
+		testCases := []struct {
+			releaseType string
+			locale      *i18n.Locale
+			expOut      string
+		}{
+			{releaseType: "theatrical", expOut: "Theatrical Release (US)"},
+			{releaseType: "digital", expOut: "Digital Release (US)"},
+			{releaseType: "theatrical", locale: i18n.French, expOut: "Sortie en salles (US)"},
+			{releaseType: "digital", locale: i18n.French, expOut: "Sortie numérique (US)"},
+		}
+
+		for _, tc := range testCases {
+			local := &localReleases{region: "US", releaseType: tc.releaseType}
+			if out := local.label(tc.locale); tc.expOut != out {
+				t.Errorf("Expected label %q, got %q.", tc.expOut, out)
+			}
+		}
+	})
+
+	t.Run("State", func(t *testing.T) {
+		resp := &account.StatesResponse{ID: 550, Favorite: true,
+			Rated: account.StateRating{Rated: true, Value: 8.5}}
+
+		var out bytes.Buffer
+		if err := printState(&out, "movie", resp, i18n.French); err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+
+		expOut := "État du compte pour movie 550\n" +
+			"Favori : oui\n" +
+			"À voir : non\n" +
+			"Note : 8,5\n"
+		if expOut != out.String() {
+			t.Errorf("Expected output:\n%s\ngot:\n%s", expOut, out.String())
+		}
+	})
+}
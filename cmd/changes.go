@@ -0,0 +1,301 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/change"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// changeValueWidth is the number of terminal cells of a change value printed
+const changeValueWidth = 50
+
+var errInvalidSince = errors.New("invalid --since value")
+
+// changesOptions holds the period and keys of the changes to report
+type changesOptions struct {
+	start time.Time
+	end   time.Time
+	keys  []string
+}
+
+func getChangesOptions(cmd *cobra.Command, now time.Time) (changesOptions, error) {
+	var copts changesOptions
+
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return copts, err
+	}
+	until, err := cmd.Flags().GetString("until")
+	if err != nil {
+		return copts, err
+	}
+	if copts.keys, err = cmd.Flags().GetStringSlice("key"); err != nil {
+		return copts, err
+	}
+
+	copts.start, copts.end, err = parsePeriod(since, until, now)
+	return copts, err
+}
+
+// parsePeriod turns --since and --until into a range of days. since is a
+// date (YYYY-MM-DD), a number of days or weeks (7d, 2w) or a duration
+// (36h), until is a date and defaults to now
+func parsePeriod(since, until string, now time.Time) (time.Time, time.Time, error) {
+	day := func(t time.Time) time.Time {
+		y, m, d := t.UTC().Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	end := day(now)
+	if until != "" {
+		t, err := time.Parse(time.DateOnly, until)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end = t
+	}
+
+	var start time.Time
+	if t, err := time.Parse(time.DateOnly, since); err == nil {
+		start = t
+	} else if n, err := strconv.Atoi(strings.TrimSuffix(since, "d")); err == nil && strings.HasSuffix(since, "d") {
+		start = day(now.AddDate(0, 0, -n))
+	} else if n, err := strconv.Atoi(strings.TrimSuffix(since, "w")); err == nil && strings.HasSuffix(since, "w") {
+		start = day(now.AddDate(0, 0, -7*n))
+	} else if d, err := time.ParseDuration(since); err == nil {
+		start = day(now.Add(-d))
+	} else {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %q", errInvalidSince, since)
+	}
+
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %q is after %s", errInvalidSince, since, end.Format(time.DateOnly))
+	}
+
+	return start, end, nil
+}
+
+// changesCmd represents the changes command
+var changesCmd = &cobra.Command{
+	Use:   "changes <media_type> <media_id>",
+	Short: "Get the changes made to a movie, TV show or person\n<media_type>: movie, tv or person",
+	Long: `Get the changes made to a movie, TV show or person.
+
+The period starts at --since, a date (YYYY-MM-DD), a number of days or weeks
+(7d, 2w) or a duration (36h), and ends at --until, today by default.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		copts, err := getChangesOptions(cmd, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return changesAction(stdout, apiRoot, args, copts, opts)
+	},
+}
+
+func changesAction(out io.Writer, apiRoot string, args []string, copts changesOptions, opts outputOptions) error {
+	mediaType := args[0]
+	switch mediaType {
+	case "movie", "tv", "person":
+	default:
+		return errors.New("invalid <media_type> value")
+	}
+
+	_, id, err := resolveID(apiRoot, mediaType, args[1], opts.language)
+	if err != nil {
+		return err
+	}
+
+	resp, err := change.GetRange(fmt.Sprintf("%s/%s/%d", apiRoot, mediaType, id), copts.start, copts.end)
+	if err != nil {
+		return err
+	}
+
+	resp.Filter(copts.keys)
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printChanges(out, fmt.Sprintf("%s %d", mediaType, id), copts, resp, opts.locale)
+}
+
+// changeValue summarizes a change value on a single line
+func changeValue(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		var b bytes.Buffer
+		if err := json.Compact(&b, value); err != nil {
+			return string(value)
+		}
+		s = b.String()
+	}
+
+	return output.Truncate(strings.Join(strings.Fields(s), " "), changeValueWidth)
+}
+
+func printChanges(out io.Writer, subject string, copts changesOptions, resp *change.Response, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	loc.Fprintf(w, "Changes for %s from %s to %s\n", subject,
+		loc.Date(copts.start.Format(time.DateOnly)), loc.Date(copts.end.Format(time.DateOnly)))
+	fmt.Fprint(w, loc.T("Time\tKey\tAction\tValue\n"))
+	for _, c := range resp.Changes {
+		for _, item := range c.Items {
+			value := item.Value
+			if len(value) == 0 {
+				value = item.OriginalValue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Time, c.Key, item.Action, changeValue(value))
+		}
+	}
+	return w.Flush()
+}
+
+// watchlistChange holds the changes made to a watchlist entry
+type watchlistChange struct {
+	MediaType string           `json:"media_type"`
+	ID        int              `json:"id"`
+	Title     string           `json:"title"`
+	Changes   *change.Response `json:"changes"`
+}
+
+var watchlistChangesCmd = &cobra.Command{
+	Use:   "changes [media_type]",
+	Short: "Report the changes made to the movies/tv shows of your watchlist\n[media_type]: movies or tv, both by default",
+	Long: `Report the changes made to the movies/tv shows of your watchlist.
+
+The period starts at --since, a date (YYYY-MM-DD), a number of days or weeks
+(7d, 2w) or a duration (36h), and ends at --until, today by default. Use --key
+to only report some changes, e.g. --key images,release_dates,overview,cast.`,
+	SilenceUsage: true,
+	Args:         cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:    []string{"movies", "tv"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		copts, err := getChangesOptions(cmd, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return watchlistChangesAction(stdout, apiRoot, args, copts, opts)
+	},
+}
+
+func watchlistChangesAction(out io.Writer, apiRoot string, args []string, copts changesOptions, opts outputOptions) error {
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	var entries []watchlistChange
+
+	if len(args) == 0 || args[0] == "movies" {
+		resp, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, "movies", opts.language)
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Results {
+			entries = append(entries, watchlistChange{MediaType: "movie", ID: r.ID, Title: r.DisplayTitle()})
+		}
+	}
+
+	if len(args) == 0 || args[0] == "tv" {
+		resp, err := account.GetWatchlist[*account.WatchlistTvResponse](url, "tv", opts.language)
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Results {
+			entries = append(entries, watchlistChange{MediaType: "tv", ID: r.ID, Title: r.DisplayName()})
+		}
+	}
+
+	// The changes are looked up concurrently, then reported in the order of
+	// the watchlist
+	found := make([]*change.Response, len(entries))
+	err := eachParallel(len(entries), func(i int) error {
+		resp, err := change.GetRange(fmt.Sprintf("%s/%s/%d", apiRoot, entries[i].MediaType, entries[i].ID),
+			copts.start, copts.end)
+		found[i] = resp
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	changed := make([]watchlistChange, 0, len(entries))
+	for i, e := range entries {
+		resp := found[i]
+		resp.Filter(copts.keys)
+		if len(resp.Changes) == 0 {
+			continue
+		}
+
+		e.Changes = resp
+		changed = append(changed, e)
+	}
+
+	if !opts.human() {
+		return render(out, opts, changed)
+	}
+
+	return printWatchlistChanges(out, copts, changed, opts.locale)
+}
+
+func printWatchlistChanges(out io.Writer, copts changesOptions, changed []watchlistChange, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Watchlist changes from %s to %s\n",
+		loc.Date(copts.start.Format(time.DateOnly)), loc.Date(copts.end.Format(time.DateOnly)))
+	if len(changed) == 0 {
+		fmt.Fprint(w, loc.T("No changes\n"))
+	}
+	for _, e := range changed {
+		keys := make([]string, 0, len(e.Changes.Changes))
+		for _, c := range e.Changes.Changes {
+			keys = append(keys, fmt.Sprintf("%s (%d)", c.Key, len(c.Items)))
+		}
+		fmt.Fprintf(w, "%s (%s %d): %s\n", e.Title, e.MediaType, e.ID, strings.Join(keys, ", "))
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(changesCmd)
+	watchlistCmd.AddCommand(watchlistChangesCmd)
+
+	for _, c := range []*cobra.Command{changesCmd, watchlistChangesCmd} {
+		c.Flags().BoolP("raw", "r", false, rawUsage)
+		c.Flags().String("since", "1d", "Start of the period: YYYY-MM-DD, 7d, 2w or a duration such as 36h")
+		c.Flags().String("until", "", "End of the period, YYYY-MM-DD (default today)")
+		c.Flags().StringSlice("key", nil, "Only show changes of these keys, e.g. images,overview")
+	}
+}
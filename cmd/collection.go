@@ -0,0 +1,285 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/collection"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	entryRated     = "rated"
+	entryFavorite  = "favorite"
+	entryWatchlist = "watchlist"
+	entryMissing   = "missing"
+)
+
+// collectionCmd represents the collection command
+var collectionCmd = &cobra.Command{
+	Use:          "collection",
+	Short:        "TMDB API for movie collections",
+	SilenceUsage: true,
+}
+
+var collectionDetailsCmd = &cobra.Command{
+	Use:          "details <collection_id>",
+	Short:        "Get the details of a collection along with its movies",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return collectionDetailsAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func collectionDetailsAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, collectionID, err := resolveID(apiRoot, "collection", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/collection/%d", apiRoot, collectionID)
+
+	resp, err := collection.GetDetails(url, opts.language)
+	if err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printCollectionDetails(out, resp, opts.locale)
+}
+
+func printCollectionDetails(out io.Writer, resp *collection.DetailsResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Collection details for %d\n", resp.ID)
+	loc.Fprintf(w, "Name: %s\n", resp.Name)
+	loc.Fprintf(w, "Poster: %s\n", resp.PosterPath)
+	loc.Fprintf(w, "Overview: %s\n", resp.Overview)
+	fmt.Fprint(w, loc.T("Movies:\n"))
+	for i, r := range resp.Parts {
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Title: %s\n", r.Title)
+		loc.Fprintf(w, "ID: %d\n", r.ID)
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	return w.Flush()
+}
+
+// collectionEntry is a movie of a collection along with its standing in the
+// account: rated, favorite, watchlist or missing
+type collectionEntry struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	ReleaseDate string  `json:"release_date"`
+	Status      string  `json:"status"`
+	Rating      float64 `json:"rating,omitempty"`
+}
+
+type collectionCompletion struct {
+	ID        int               `json:"id"`
+	Name      string            `json:"name"`
+	Rated     int               `json:"rated"`
+	Favorite  int               `json:"favorite"`
+	Watchlist int               `json:"watchlist"`
+	Missing   int               `json:"missing"`
+	Entries   []collectionEntry `json:"entries"`
+}
+
+var collectionCompletionCmd = &cobra.Command{
+	Use:   "completion",
+	Short: "Report how complete the collections of your favorite and rated movies are",
+	Long: `Report how complete the collections of your favorite and rated movies are.
+
+Every movie of those collections is marked as rated, favorite, watchlist or
+missing.`,
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return collectionCompletionAction(stdout, apiRoot, opts)
+	},
+}
+
+func collectionCompletionAction(out io.Writer, apiRoot string, opts outputOptions) error {
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	favorites, err := account.GetFavorite[*account.FavoriteMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	rated, err := account.GetRatedShow[*account.RatedMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	watchlist, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	var (
+		seen        = make(map[int]bool)
+		seeds       []int
+		ratings     = make(map[int]float64)
+		favorite    = make(map[int]bool)
+		watchlisted = make(map[int]bool)
+	)
+	for _, r := range rated.Results {
+		ratings[r.ID] = r.Rating
+		if !seen[r.ID] {
+			seen[r.ID] = true
+			seeds = append(seeds, r.ID)
+		}
+	}
+	for _, r := range favorites.Results {
+		favorite[r.ID] = true
+		if !seen[r.ID] {
+			seen[r.ID] = true
+			seeds = append(seeds, r.ID)
+		}
+	}
+	for _, r := range watchlist.Results {
+		watchlisted[r.ID] = true
+	}
+
+	// The seeds are looked up concurrently, then their collections are
+	// fetched once each, in the order of the seeds
+	seedDetails := make([]*movie.DetailsResponse, len(seeds))
+	err = eachParallel(len(seeds), func(i int) error {
+		details, err := movie.GetDetails(fmt.Sprintf("%s/movie/%d", apiRoot, seeds[i]), opts.language)
+		seedDetails[i] = details
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	collections := make(map[int]bool)
+	var collectionIDs []int
+	for _, details := range seedDetails {
+		if details.BelongsToCollection == nil || collections[details.BelongsToCollection.ID] {
+			continue
+		}
+		collections[details.BelongsToCollection.ID] = true
+		collectionIDs = append(collectionIDs, details.BelongsToCollection.ID)
+	}
+
+	parts := make([]*collection.DetailsResponse, len(collectionIDs))
+	err = eachParallel(len(collectionIDs), func(i int) error {
+		resp, err := collection.GetDetails(fmt.Sprintf("%s/collection/%d", apiRoot, collectionIDs[i]), opts.language)
+		parts[i] = resp
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var report []collectionCompletion
+	for _, resp := range parts {
+		c := collectionCompletion{ID: resp.ID, Name: resp.Name, Entries: []collectionEntry{}}
+		for _, p := range resp.Parts {
+			e := collectionEntry{ID: p.ID, Title: p.Title, ReleaseDate: p.ReleaseDate}
+
+			if rating, ok := ratings[p.ID]; ok {
+				e.Status, e.Rating = entryRated, rating
+				c.Rated++
+			} else if favorite[p.ID] {
+				e.Status = entryFavorite
+				c.Favorite++
+			} else if watchlisted[p.ID] {
+				e.Status = entryWatchlist
+				c.Watchlist++
+			} else {
+				e.Status = entryMissing
+				c.Missing++
+			}
+
+			c.Entries = append(c.Entries, e)
+		}
+
+		// Unreleased movies have no date yet, keep them last
+		sort.SliceStable(c.Entries, func(i, j int) bool {
+			di, dj := c.Entries[i].ReleaseDate, c.Entries[j].ReleaseDate
+			if di == "" || dj == "" {
+				return di != "" && dj == ""
+			}
+			return di < dj
+		})
+
+		report = append(report, c)
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Name < report[j].Name
+	})
+
+	if !opts.human() {
+		return render(out, opts, report)
+	}
+
+	return printCollectionCompletion(out, report, opts.locale)
+}
+
+func printCollectionCompletion(out io.Writer, report []collectionCompletion, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	for i, c := range report {
+		if i > 0 {
+			fmt.Fprint(w, "\n")
+		}
+		fmt.Fprintf(w, "%s (%d)\n", c.Name, c.ID)
+		loc.Fprintf(w, "Rated: %d/%d, Favorite: %d, Watchlist: %d, Missing: %d\n",
+			c.Rated, len(c.Entries), c.Favorite, c.Watchlist, c.Missing)
+		fmt.Fprint(w, loc.T("ID\tTitle\tRelease Date\tStatus\n"))
+		for _, e := range c.Entries {
+			status := loc.T(e.Status)
+			if e.Status == entryRated {
+				status = fmt.Sprintf("%s %s", status, loc.Float(e.Rating, 1))
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", e.ID, e.Title, loc.Date(e.ReleaseDate), status)
+		}
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(collectionCmd)
+
+	collectionCmd.AddCommand(collectionDetailsCmd)
+	collectionCmd.AddCommand(collectionCompletionCmd)
+
+	collectionDetailsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+	collectionCompletionCmd.Flags().BoolP("raw", "r", false, rawUsage)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/output"
+)
+
+// mediaField is a field of the movies and TV shows printed by the list
+// commands, which --columns and --sort-by refer to by name
+type mediaField struct {
+	label string
+	kind  output.Kind
+	value func(m account.Media, genres genre.Names) any
+}
+
+var mediaFields = map[string]mediaField{
+	"id":           {"ID", output.Meta, func(m account.Media, _ genre.Names) any { return m.ID }},
+	"title":        {"Title", output.Plain, func(m account.Media, _ genre.Names) any { return m.Title }},
+	"year":         {"Year", output.Meta, func(m account.Media, _ genre.Names) any { return strings.SplitN(m.Date, "-", 2)[0] }},
+	"date":         {"Date", output.Meta, func(m account.Media, _ genre.Names) any { return m.Date }},
+	"genres":       {"Genres", output.Meta, func(m account.Media, g genre.Names) any { return g.Join(m.GenreIds) }},
+	"overview":     {"Overview", output.Plain, func(m account.Media, _ genre.Names) any { return m.Overview }},
+	"poster":       {"Poster", output.Meta, func(m account.Media, _ genre.Names) any { return m.PosterPath }},
+	"popularity":   {"Popularity", output.Meta, func(m account.Media, _ genre.Names) any { return m.Popularity }},
+	"vote_average": {"Vote Average", output.Vote, func(m account.Media, _ genre.Names) any { return m.VoteAverage }},
+	"vote_count":   {"Vote Count", output.Meta, func(m account.Media, _ genre.Names) any { return m.VoteCount }},
+	"rating":       {"Rating", output.Rating, func(m account.Media, _ genre.Names) any { return m.Rating }},
+}
+
+// mediaResult is implemented by the results of the list commands
+type mediaResult interface {
+	Media() account.Media
+}
+
+// fieldNames returns the names of the media fields, for the error messages
+func fieldNames() string {
+	names := make([]string, 0, len(mediaFields))
+	for n := range mediaFields {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// validateColumns checks the --columns and --sort-by values
+func validateColumns(columns []string, sortBy string) error {
+	for _, c := range columns {
+		if _, ok := mediaFields[c]; !ok {
+			return fmt.Errorf("invalid --columns value %q, use %s", c, fieldNames())
+		}
+	}
+
+	if sortBy == "" {
+		return nil
+	}
+
+	field, order, _ := strings.Cut(sortBy, ":")
+	if _, ok := mediaFields[field]; !ok {
+		return fmt.Errorf("invalid --sort-by field %q, use %s", field, fieldNames())
+	}
+	if order != "" && order != "asc" && order != "desc" {
+		return fmt.Errorf("invalid --sort-by order %q, use asc or desc", order)
+	}
+
+	return nil
+}
+
+// needsGenres reports whether the --columns or --sort-by fields need the
+// genre names, which the default columns have
+func (o outputOptions) needsGenres() bool {
+	field, _, _ := strings.Cut(o.sortBy, ":")
+	return field == "genres" || slices.Contains(o.columns, "genres") ||
+		len(o.columns) == 0 && o.tabular()
+}
+
+// compareValues orders two values of a media field, the text ones ignoring
+// the case
+func compareValues(a, b any) int {
+	switch a := a.(type) {
+	case int:
+		return cmp.Compare(a, b.(int))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case string:
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b.(string)))
+	}
+	return 0
+}
+
+// sortMedia sorts results on the --sort-by field[:desc], the results having
+// the same value keeping their order
+func sortMedia[T mediaResult](results []T, genres genre.Names, sortBy string) {
+	if sortBy == "" {
+		return
+	}
+
+	name, order, _ := strings.Cut(sortBy, ":")
+	field := mediaFields[name]
+
+	sort.SliceStable(results, func(i, j int) bool {
+		c := compareValues(field.value(results[i].Media(), genres), field.value(results[j].Media(), genres))
+		if order == "desc" {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// Fields of the list commands, in the order of their csv and tsv columns.
+// Episodes have no genres nor popularity.
+var (
+	mediaFieldNames   = []string{"id", "title", "year", "date", "genres", "overview", "poster", "popularity", "vote_average", "vote_count", "rating"}
+	episodeFieldNames = []string{"id", "title", "year", "date", "overview", "poster", "vote_average", "vote_count", "rating"}
+)
+
+// Help of the --columns flags of the list commands, the csv and tsv output
+// having every field unless they are given
+const (
+	columnsUsage        = "Print these fields, by default the main ones as a table and all of them as csv or tsv: id, title, year, date, genres, overview, poster, popularity, vote_average, vote_count, rating"
+	episodeColumnsUsage = "Print these fields, by default the main ones as a table and all of them as csv or tsv: id, title, year, date, overview, poster, vote_average, vote_count, rating"
+)
+
+// selectColumns returns the --columns, or else the defaults for the tables
+// printed to a terminal and every one of fields for csv and tsv
+func selectColumns(opts outputOptions, defaults, fields []string) []string {
+	switch {
+	case len(opts.columns) > 0:
+		return opts.columns
+	case opts.human():
+		return defaults
+	}
+	return fields
+}
+
+// Default columns of the tables printed to a terminal
+var (
+	mediaColumns   = []string{"title", "date", "genres", "popularity", "vote_count", "vote_average"}
+	ratedColumns   = []string{"title", "date", "genres", "vote_average", "rating"}
+	listColumns    = []string{"id", "title", "date", "vote_average"}
+	episodeColumns = []string{"title", "date", "vote_average", "rating"}
+)
+
+// tabular reports whether the list commands print their results as a table
+// of columns: when --columns is given without --template, for the text
+// format printed to a terminal, and for csv and tsv, so that their header
+// is the same whether the pages are streamed or not
+func (o outputOptions) tabular() bool {
+	if o.template != nil {
+		return false
+	}
+	switch {
+	case len(o.columns) > 0, o.terminal && o.human():
+		return true
+	case o.query == nil:
+		f := o.outputFormat()
+		return f == "csv" || f == "tsv"
+	}
+	return false
+}
+
+// mediaRecords returns the columns of results as records for the
+// structured formats, the missing ratings being null
+func mediaRecords[T mediaResult](results []T, genres genre.Names, columns []string) []*output.Object {
+	records := make([]*output.Object, 0, len(results))
+	for _, r := range results {
+		m := r.Media()
+		obj := output.NewObject()
+		for _, c := range columns {
+			v := mediaFields[c].value(m, genres)
+			if c == "rating" && m.Rating == 0 {
+				v = nil
+			}
+			obj.Set(c, v)
+		}
+		records = append(records, obj)
+	}
+	return records
+}
+
+// printColumns prints the columns of results, see selectColumns, as a table
+// fitting in the terminal for the text format and as records having these
+// fields otherwise
+func printColumns[T mediaResult](out io.Writer, opts outputOptions, results []T, genres genre.Names, columns []string) error {
+	if !opts.human() {
+		return render(out, opts, mediaRecords(results, genres, columns))
+	}
+
+	t := mediaTable(out, opts, columns)
+	if err := t.Write(mediaRows(opts, results, genres, columns)); err != nil {
+		return err
+	}
+	return t.Close()
+}
+
+// mediaTable returns the table of columns printed to out, its header in the
+// --ui-language
+func mediaTable(out io.Writer, opts outputOptions, columns []string) *output.TableStream {
+	header := make([]string, len(columns))
+	kinds := make([]output.Kind, len(columns))
+	for i, c := range columns {
+		header[i] = opts.locale.T(mediaFields[c].label)
+		kinds[i] = mediaFields[c].kind
+	}
+
+	return output.NewTableStream(out, header, kinds, opts.renderOptions())
+}
+
+// mediaRows returns the cells of the columns of results, formatted for the
+// --ui-language
+func mediaRows[T mediaResult](opts outputOptions, results []T, genres genre.Names, columns []string) [][]string {
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		m := r.Media()
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			switch v := mediaFields[c].value(m, genres).(type) {
+			case float64:
+				cells[i] = opts.locale.Float(v, 2)
+				if c == "rating" {
+					cells[i] = "-"
+					if v != 0 {
+						cells[i] = opts.locale.Float(v, 1)
+					}
+				}
+			case string:
+				if c == "date" {
+					v = opts.locale.Date(v)
+				}
+				cells[i] = dash(strings.Join(strings.Fields(v), " "))
+			default:
+				cells[i] = dash(strings.Join(strings.Fields(fmt.Sprint(v)), " "))
+			}
+		}
+		rows = append(rows, cells)
+	}
+
+	return rows
+}
@@ -0,0 +1,152 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/company"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// companyCmd represents the company command
+var companyCmd = &cobra.Command{
+	Use:          "company",
+	Short:        "TMDB API for production companies",
+	SilenceUsage: true,
+}
+
+var companyDetailsCmd = &cobra.Command{
+	Use:          "details <company_id>",
+	Short:        "Get the details of a company",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return companyDetailsAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func companyDetailsAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, companyID, err := resolveID(apiRoot, "company", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/company/%d", apiRoot, companyID)
+
+	resp, err := company.GetDetails(url)
+	if err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printCompanyDetails(out, resp, opts.locale)
+}
+
+func printCompanyDetails(out io.Writer, resp *company.DetailsResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Company details for %d\n", resp.ID)
+	loc.Fprintf(w, "Name: %s\n", resp.Name)
+	loc.Fprintf(w, "Headquarters: %s\n", resp.Headquarters)
+	loc.Fprintf(w, "Origin Country: %s\n", resp.OriginCountry)
+	loc.Fprintf(w, "Homepage: %s\n", resp.Homepage)
+	if resp.ParentCompany != nil {
+		loc.Fprintf(w, "Parent Company: %s\n", resp.ParentCompany.Name)
+	}
+	loc.Fprintf(w, "Logo: %s\n", resp.LogoPath)
+	loc.Fprintf(w, "Description: %s\n", resp.Description)
+	return w.Flush()
+}
+
+var companyMoviesCmd = &cobra.Command{
+	Use:          "movies <company_id> [page]",
+	Short:        "Get the movies produced by a company, most popular first",
+	SilenceUsage: true,
+	Args:         cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return companyMoviesAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func companyMoviesAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, companyID, err := resolveID(apiRoot, "company", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	page := 1
+	if len(args) > 1 {
+		page, err = strconv.Atoi(args[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := company.GetMovies(apiRoot, companyID, page, opts.language)
+	if err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printCompanyMovies(out, companyID, resp, opts.locale)
+}
+
+func printCompanyMovies(out io.Writer, companyID int, resp *company.MoviesResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Movies by company %d (page %d of %d)\n", companyID, resp.Page, resp.TotalPages)
+	for i, r := range resp.Results {
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Title: %s\n", r.Title)
+		loc.Fprintf(w, "ID: %d\n", r.ID)
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(companyCmd)
+
+	companyCmd.AddCommand(companyDetailsCmd)
+	companyCmd.AddCommand(companyMoviesCmd)
+
+	companyDetailsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+	companyMoviesCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
@@ -8,13 +8,13 @@ package cmd
 import (
 	"fmt"
 	"io"
-	"os"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/i18n"
 )
 
 // detailsCmd represents the details command
@@ -30,16 +30,16 @@ var detailsCmd = &cobra.Command{
 			return err
 		}
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return detailsAction(os.Stdout, apiRoot, accountID, isRaw)
+		return detailsAction(stdout, apiRoot, accountID, opts)
 	},
 }
 
-func detailsAction(out io.Writer, apiRoot, accountID string, isRaw bool) error {
+func detailsAction(out io.Writer, apiRoot, accountID string, opts outputOptions) error {
 	url := fmt.Sprintf("%s/account/%s", apiRoot, accountID)
 
 	resp, err := account.GetDetails(url)
@@ -47,18 +47,23 @@ func detailsAction(out io.Writer, apiRoot, accountID string, isRaw bool) error {
 		return err
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
 	}
 
-	return printDetails(out, resp)
+	return printDetails(out, resp, opts.locale)
 }
 
-func printDetails(out io.Writer, resp *account.DetailsResponse) error {
+func printDetails(out io.Writer, resp *account.DetailsResponse, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
-	fmt.Fprintf(w, "Account details for %d\n", resp.ID)
-	fmt.Fprintf(w, "ID: %d\n", resp.ID)
-	fmt.Fprintf(w, "Username: %s\n", resp.Username)
+	loc.Fprintf(w, "Account details for %d\n", resp.ID)
+	loc.Fprintf(w, "ID: %d\n", resp.ID)
+	loc.Fprintf(w, "Username: %s\n", resp.Username)
+	loc.Fprintf(w, "Avatar: %s\n", resp.Avatar.Tmdb.AvatarPath)
 	return w.Flush()
 }
 
@@ -72,7 +77,7 @@ func init() {
 	// detailsCmd.PersistentFlags().String("foo", "", "A help for foo")
 
 	detailsCmd.Flags().String("account-id", "null", "Specify the account id")
-	detailsCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
+	detailsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// detailsCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
@@ -30,7 +30,7 @@ var docsCmd = &cobra.Command{
 			}
 		}
 
-		return docsAction(os.Stdout, dir)
+		return docsAction(stdout, dir)
 	},
 }
 
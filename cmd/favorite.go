@@ -9,11 +9,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"strconv"
 	"text/tabwriter"
 
 	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,27 +26,41 @@ var favoriteCmd = &cobra.Command{
 }
 
 var addCmd = &cobra.Command{
-	Use:          "add <media_type> <media_id> <is_favourite>",
-	Short:        "Mark a movie or TV show as a favourite\n\n<media_type>: movies or tv\n<media_id>: valid media id (integer)\n<is_favourite>: yes or no\n",
+	Use:          "add [media_type] <media_id> <is_favourite>",
+	Short:        "Mark a movie or TV show as a favourite\n\n[media_type]: movie or tv, may be left out when <media_id> tells it\n<media_id>: TMDB id, movie/550, IMDb/TVDB id or URL\n<is_favourite>: yes or no\n",
 	SilenceUsage: true,
-	Args:         cobra.ExactArgs(3),
+	Args:         cobra.RangeArgs(2, 3),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
-		return addAction(os.Stdout, apiRoot, args)
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return addAction(stdout, apiRoot, args, opts)
 	},
 }
 
-func addAction(out io.Writer, apiRoot string, args []string) error {
-	mediaType := args[0]
-	if mediaType != "movie" && mediaType != "tv" {
+func addAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	// <media_type> may be left out when the id tells it, e.g. a URL
+	if len(args) == 2 {
+		args = append([]string{""}, args...)
+	}
+
+	if args[0] != "" && args[0] != "movie" && args[0] != "tv" {
 		return errors.New("invalid <media_type> value")
 	}
 
-	mediaID, err := strconv.Atoi(args[1])
+	mediaType, mediaID, err := resolveID(apiRoot, args[0], args[1], opts.language)
 	if err != nil {
 		return err
 	}
 
+	if mediaType != "movie" && mediaType != "tv" {
+		return errors.New("invalid <media_type> value")
+	}
+
 	var isFavourite bool
 	switch args[2] {
 	case "yes":
@@ -64,7 +78,7 @@ func addAction(out io.Writer, apiRoot string, args []string) error {
 		return err
 	}
 
-	return printResp(out, resp)
+	return render(out, opts, resp)
 }
 
 var getCmd = &cobra.Command{
@@ -76,71 +90,91 @@ var getCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return getAction(os.Stdout, apiRoot, args, isRaw)
+		return getAction(stdout, apiRoot, args, opts)
 	},
 }
 
-func getAction(out io.Writer, apiRoot string, args []string, isRaw bool) error {
+func getAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
 
 	url := fmt.Sprintf("%s/account/null", apiRoot)
 
 	mediaType := args[0]
 
-	if mediaType == "movies" {
-		resp, err := account.GetFavorite[*account.FavoriteMoviesResponse](url, mediaType)
-		if err != nil {
-			return err
-		}
-
-		if isRaw {
-			return printResp(out, resp)
-		}
-
-		return printFavMovies(out, resp)
-	}
-	resp, err := account.GetFavorite[*account.FavoriteTvResponse](url, mediaType)
+	genres, match, err := loadGenres(apiRoot, mediaType, opts)
 	if err != nil {
 		return err
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	if mediaType == "movies" {
+		return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.FavoriteMoviesResponse, account.FavoriteMovie]{
+			get: func() (*account.FavoriteMoviesResponse, error) {
+				return account.GetFavorite[*account.FavoriteMoviesResponse](url, mediaType, opts.language)
+			},
+			pages: func(fn func(*account.FavoriteMoviesResponse) error) error {
+				return account.FavoritePages(url, mediaType, opts.language, fn)
+			},
+			results: func(resp *account.FavoriteMoviesResponse) *[]account.FavoriteMovie { return &resp.Results },
+			print: func(out io.Writer, resp *account.FavoriteMoviesResponse, from int) error {
+				return printFavMovies(out, resp, genres, from, opts.locale)
+			},
+			columns: mediaColumns,
+		})
 	}
 
-	return printFavTv(out, resp)
+	return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.FavoriteTvResponse, account.FavoriteTv]{
+		get: func() (*account.FavoriteTvResponse, error) {
+			return account.GetFavorite[*account.FavoriteTvResponse](url, mediaType, opts.language)
+		},
+		pages: func(fn func(*account.FavoriteTvResponse) error) error {
+			return account.FavoritePages(url, mediaType, opts.language, fn)
+		},
+		results: func(resp *account.FavoriteTvResponse) *[]account.FavoriteTv { return &resp.Results },
+		print: func(out io.Writer, resp *account.FavoriteTvResponse, from int) error {
+			return printFavTv(out, resp, genres, from, opts.locale)
+		},
+		columns: mediaColumns,
+	})
 }
 
-func printFavMovies(out io.Writer, resp *account.FavoriteMoviesResponse) error {
+func printFavMovies(out io.Writer, resp *account.FavoriteMoviesResponse, genres genre.Names, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
-	fmt.Fprint(w, "Favorite Movies:\n")
+	if resp.Page <= 1 {
+		fmt.Fprint(w, loc.T("Favorite Movies:\n"))
+	}
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Title: %s\n", r.Title)
-		fmt.Fprintf(w, "Release Date: %s\n", r.ReleaseDate)
-		fmt.Fprintf(w, "Popularity: %.2f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %.2f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
 	}
 	return w.Flush()
 }
 
-func printFavTv(out io.Writer, resp *account.FavoriteTvResponse) error {
+func printFavTv(out io.Writer, resp *account.FavoriteTvResponse, genres genre.Names, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
-	fmt.Fprint(w, "Favorite TV Shows:\n")
+	if resp.Page <= 1 {
+		fmt.Fprint(w, loc.T("Favorite TV Shows:\n"))
+	}
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Name: %s\n", r.Name)
-		fmt.Fprintf(w, "First Air Date: %s\n", r.FirstAirDate)
-		fmt.Fprintf(w, "Popularity: %.2f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %.2f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Name: %s\n", r.DisplayName())
+		loc.Fprintf(w, "First Air Date: %s\n", loc.Date(r.FirstAirDate))
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
 	}
 	return w.Flush()
 }
@@ -156,7 +190,10 @@ func init() {
 	// and all subcommands, e.g.:
 	// favoriteCmd.PersistentFlags().String("foo", "", "A help for foo")
 
-	getCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
+	getCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	getCmd.Flags().StringSlice("genre", nil, "Only show results having all these genres")
+	getCmd.Flags().StringSlice("columns", nil, columnsUsage)
+	getCmd.Flags().String("sort-by", "", "Sort on a --columns field, field:desc for descending order")
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
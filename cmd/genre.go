@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// genreCmd represents the genre command
+var genreCmd = &cobra.Command{
+	Use:          "genre",
+	Short:        "TMDB API for genres",
+	SilenceUsage: true,
+}
+
+var genreListCmd = &cobra.Command{
+	Use:          "list <media_type>",
+	Short:        "Get the list of official genres for movies/tv shows\n<media_type>: movie or tv",
+	SilenceUsage: true,
+	Args:         cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:    []string{"movie", "tv"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return genreListAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func genreListAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	names, err := genre.Load(context.Background(), apiRoot, args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, names.List())
+	}
+
+	return printGenres(out, names, opts.locale)
+}
+
+func printGenres(out io.Writer, names genre.Names, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	fmt.Fprint(w, loc.T("Genres:\n"))
+	for i, name := range names.Sorted() {
+		fmt.Fprintf(w, "%d. %s\n", i+1, name)
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(genreCmd)
+
+	genreCmd.AddCommand(genreListCmd)
+
+	genreListCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
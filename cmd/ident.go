@@ -0,0 +1,125 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"example.com/dummyheaad/tmdbCLI/find"
+)
+
+var (
+	errMediaTypeRequired = errors.New("<media_type> is required for plain TMDB ids")
+	errNoMatch           = errors.New("no TMDB entry found")
+	errMediaTypeMismatch = errors.New("identifier doesn't match <media_type>")
+	errTVDBSlug          = errors.New("thetvdb.com URLs naming the series by slug can't be resolved, use its id, e.g. tvdb:81189")
+	errNoExternalID      = errors.New("only movies, TV shows and people have external ids")
+)
+
+// findOrder is the order the media types are tried in when an external id
+// is resolved without a media type
+var findOrder = []string{"movie", "tv", "person"}
+
+var (
+	imdbRe     = regexp.MustCompile(`^(tt|nm)\d+$`)
+	tvdbRe     = regexp.MustCompile(`^tvdb[:/](\d+)$`)
+	tmdbPathRe = regexp.MustCompile(`^/?(movie|tv|person|collection|company|network)/(\d+)(?:-[^/]*)?(?:/.*)?$`)
+	// imdbPathRe matches the imdb.com title and name paths, the localized
+	// ones starting with a language, e.g. /fr/title/tt0137523/
+	imdbPathRe = regexp.MustCompile(`^/(?:[a-z]{2}(?:-[a-z]{2})?/)?(?:title|name)/((?:tt|nm)\d+)(?:/.*)?$`)
+)
+
+// resolveID turns ident into the TMDB id of a movie, TV show, person,
+// collection, company or network.
+// ident is either a TMDB id (550), a TMDB path (movie/550, collection/10),
+// an IMDb id (tt0137523, nm0000093), a TVDB id (tvdb:81189) or a
+// themoviedb.org, imdb.com or thetvdb.com URL, the latter carrying the id.
+// External ids are looked up through /find in language and only name
+// movies, TV shows and people.
+// An empty mediaType is inferred from ident, the resolved one is returned
+func resolveID(apiRoot, mediaType, ident, language string) (string, int, error) {
+	ident = strings.TrimSpace(ident)
+
+	if id, err := strconv.Atoi(ident); err == nil {
+		if mediaType == "" {
+			return "", 0, errMediaTypeRequired
+		}
+		return mediaType, id, nil
+	}
+
+	if u, err := url.Parse(ident); err == nil && u.Host != "" {
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		switch {
+		case host == "themoviedb.org":
+			ident = u.Path
+		case host == "imdb.com" || strings.HasSuffix(host, ".imdb.com"):
+			// e.g. /title/tt0137523/, /name/nm0000093/ or /fr/title/tt0137523/
+			m := imdbPathRe.FindStringSubmatch(strings.ToLower(u.Path))
+			if m == nil {
+				return "", 0, fmt.Errorf("invalid id %q: %w", ident, strconv.ErrSyntax)
+			}
+			ident = m[1]
+		case host == "thetvdb.com":
+			// Only the legacy URLs carry the id, e.g. /?tab=series&id=81189,
+			// the current ones name the series, e.g. /series/breaking-bad
+			id := u.Query().Get("id")
+			if id == "" {
+				return "", 0, fmt.Errorf("%w: %s", errTVDBSlug, ident)
+			}
+			ident = "tvdb:" + id
+		default:
+			return "", 0, fmt.Errorf("invalid id %q: %w", ident, strconv.ErrSyntax)
+		}
+	}
+
+	var externalID, source string
+
+	switch {
+	case tmdbPathRe.MatchString(ident):
+		m := tmdbPathRe.FindStringSubmatch(ident)
+		if mediaType != "" && m[1] != mediaType {
+			return "", 0, fmt.Errorf("%w: %s is a %s", errMediaTypeMismatch, ident, m[1])
+		}
+		id, err := strconv.Atoi(m[2])
+		if err != nil {
+			return "", 0, err
+		}
+		return m[1], id, nil
+	case imdbRe.MatchString(ident):
+		externalID, source = ident, find.SourceIMDb
+	case tvdbRe.MatchString(ident):
+		externalID, source = tvdbRe.FindStringSubmatch(ident)[1], find.SourceTVDB
+	default:
+		return "", 0, fmt.Errorf("invalid id %q: %w", ident, strconv.ErrSyntax)
+	}
+
+	if mediaType != "" && !slices.Contains(findOrder, mediaType) {
+		return "", 0, fmt.Errorf("%w: %s", errNoExternalID, ident)
+	}
+
+	resp, err := find.Get(lookupCtx, apiRoot, externalID, source, language)
+	if err != nil {
+		return "", 0, err
+	}
+
+	types := findOrder
+	if mediaType != "" {
+		types = []string{mediaType}
+	}
+	for _, t := range types {
+		if ids := resp.IDs(t); len(ids) > 0 {
+			return t, ids[0], nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("%w: %s", errNoMatch, ident)
+}
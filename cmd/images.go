@@ -0,0 +1,334 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/configuration"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/images"
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"example.com/dummyheaad/tmdbCLI/tv"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// imagesCmd represents the images command
+var imagesCmd = &cobra.Command{
+	Use:          "images",
+	Short:        "Work with TMDB posters, backdrops and stills",
+	SilenceUsage: true,
+}
+
+// downloadOptions holds the settings of an images download
+type downloadOptions struct {
+	kinds       []string
+	dir         string
+	template    string
+	manifest    string
+	concurrency int
+}
+
+var imagesDownloadCmd = &cobra.Command{
+	Use:   "download <source> <args...>",
+	Short: "Download the images of movies/tv shows or of a whole account list",
+	Long: `Download the posters, backdrops or stills of movies/tv shows.
+
+<source> is one of:
+  movie <movie_id>...           the given movies
+  tv <tv_id>...                 the given TV shows
+  favorite <movies|tv>          your favorite movies/tv shows
+  watchlist <movies|tv>         your watchlist
+  rated <movies|tv|episodes>    your rated movies/tv shows/episodes
+
+Files already present in the directory are skipped, and a manifest describing
+every image is written next to them. The file names come from --name-template,
+a Go template receiving .MediaType, .ID, .Title, .Slug, .Kind, .Size and .Ext.`,
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		var dl downloadOptions
+
+		if dl.kinds, err = cmd.Flags().GetStringSlice("kind"); err != nil {
+			return err
+		}
+		// Episodes only have stills
+		if !cmd.Flags().Changed("kind") && len(args) > 1 && args[0] == "rated" && args[1] == "episodes" {
+			dl.kinds = []string{"still"}
+		}
+		if dl.dir, err = cmd.Flags().GetString("dir"); err != nil {
+			return err
+		}
+		if dl.template, err = cmd.Flags().GetString("name-template"); err != nil {
+			return err
+		}
+		if dl.manifest, err = cmd.Flags().GetString("manifest"); err != nil {
+			return err
+		}
+		if dl.concurrency, err = cmd.Flags().GetInt("concurrency"); err != nil {
+			return err
+		}
+
+		return imagesDownloadAction(stdout, apiRoot, args, dl, opts)
+	},
+}
+
+// imageSource is a movie, TV show or episode along with its image paths
+type imageSource struct {
+	mediaType string
+	id        int
+	title     string
+	paths     map[string]string
+}
+
+func imagesDownloadAction(out io.Writer, apiRoot string, args []string, dl downloadOptions, opts outputOptions) error {
+	for _, k := range dl.kinds {
+		if k != "poster" && k != "backdrop" && k != "still" {
+			return fmt.Errorf("invalid --kind value %q", k)
+		}
+	}
+
+	sources, err := getImageSources(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	if len(sources) > 0 && !hasImageKind(sources, dl.kinds) {
+		return fmt.Errorf("no %s images for %s %s", strings.Join(dl.kinds, " or "), args[0], strings.Join(args[1:], " "))
+	}
+
+	cfg, err := configuration.Load(lookupCtx, apiRoot)
+	if err != nil {
+		return err
+	}
+
+	var items []images.Item
+	for _, s := range sources {
+		for _, k := range dl.kinds {
+			p := s.paths[k]
+			if p == "" {
+				continue
+			}
+
+			size, err := cfg.Size(k, opts.imageSize)
+			if err != nil {
+				return err
+			}
+
+			url, err := cfg.ImageURL(k, p, size)
+			if err != nil {
+				return err
+			}
+
+			items = append(items, images.Item{
+				MediaType: s.mediaType,
+				ID:        s.id,
+				Title:     s.title,
+				Kind:      k,
+				Size:      size,
+				URL:       url,
+			})
+		}
+	}
+
+	m, dlErr := images.Download(items, images.Options{
+		Dir:          dl.dir,
+		Template:     dl.template,
+		Concurrency:  dl.concurrency,
+		ManifestName: dl.manifest,
+	})
+	if m == nil {
+		return dlErr
+	}
+
+	if !opts.human() {
+		if err := render(out, opts, m); err != nil {
+			return err
+		}
+		return dlErr
+	}
+
+	if err := printManifest(out, m, filepath.Join(dl.dir, dl.manifest), opts.locale); err != nil {
+		return err
+	}
+	return dlErr
+}
+
+// hasImageKind tells whether any of the sources has an image of kinds, e.g.
+// the episodes only having stills and some entries no image at all
+func hasImageKind(sources []imageSource, kinds []string) bool {
+	for _, s := range sources {
+		for _, k := range kinds {
+			if s.paths[k] != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getImageSources resolves the download source arguments into the list of
+// movies, TV shows or episodes to fetch the images of, their titles naming
+// the files in language
+func getImageSources(apiRoot string, args []string, language string) ([]imageSource, error) {
+	var sources []imageSource
+
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	source, params := args[0], args[1:]
+
+	switch source {
+	case "movie", "tv":
+		for _, a := range params {
+			_, id, err := resolveID(apiRoot, source, a, language)
+			if err != nil {
+				return nil, err
+			}
+
+			u := fmt.Sprintf("%s/%s/%d", apiRoot, source, id)
+
+			if source == "movie" {
+				resp, err := movie.GetDetails(u, language)
+				if err != nil {
+					return nil, err
+				}
+				sources = append(sources, imageSource{"movie", resp.ID, resp.DisplayTitle(),
+					map[string]string{"poster": resp.PosterPath, "backdrop": resp.BackdropPath}})
+				continue
+			}
+
+			resp, err := tv.GetDetails(u, language)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, imageSource{"tv", resp.ID, resp.DisplayName(),
+				map[string]string{"poster": resp.PosterPath, "backdrop": resp.BackdropPath}})
+		}
+	case "favorite":
+		switch params[0] {
+		case "movies":
+			resp, err := account.GetFavorite[*account.FavoriteMoviesResponse](url, "movies", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"movie", r.ID, r.DisplayTitle(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		case "tv":
+			resp, err := account.GetFavorite[*account.FavoriteTvResponse](url, "tv", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"tv", r.ID, r.DisplayName(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		default:
+			return nil, errors.New("invalid <media_type> value")
+		}
+	case "watchlist":
+		switch params[0] {
+		case "movies":
+			resp, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, "movies", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"movie", r.ID, r.DisplayTitle(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		case "tv":
+			resp, err := account.GetWatchlist[*account.WatchlistTvResponse](url, "tv", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"tv", r.ID, r.DisplayName(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		default:
+			return nil, errors.New("invalid <media_type> value")
+		}
+	case "rated":
+		switch params[0] {
+		case "movies":
+			resp, err := account.GetRatedShow[*account.RatedMoviesResponse](url, "movies", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"movie", r.ID, r.DisplayTitle(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		case "tv":
+			resp, err := account.GetRatedShow[*account.RatedTvResponse](url, "tv", language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"tv", r.ID, r.DisplayName(),
+					map[string]string{"poster": r.PosterPath, "backdrop": r.BackdropPath}})
+			}
+		case "episodes":
+			resp, err := account.GetRatedEpisodes(url, language)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Results {
+				sources = append(sources, imageSource{"episode", r.ID, r.Name,
+					map[string]string{"still": r.StillPath}})
+			}
+		default:
+			return nil, errors.New("invalid <media_type> value")
+		}
+	default:
+		return nil, errors.New("invalid <source> value")
+	}
+
+	return sources, nil
+}
+
+func printManifest(out io.Writer, m *images.Manifest, manifestFile string, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	fmt.Fprint(w, loc.T("Images:\n"))
+	for i, e := range m.Entries {
+		fmt.Fprintf(w, "%d. %s (%s)\n", i+1, e.File, e.Status)
+		if e.Error != "" {
+			loc.Fprintf(w, "Error: %s\n", e.Error)
+		}
+	}
+	loc.Fprintf(w, "Downloaded: %d, Skipped: %d, Failed: %d\n", m.Downloaded, m.Skipped, m.Failed)
+	loc.Fprintf(w, "Manifest: %s\n", manifestFile)
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(imagesCmd)
+
+	imagesCmd.AddCommand(imagesDownloadCmd)
+
+	imagesDownloadCmd.Flags().StringSlice("kind", []string{"poster"},
+		"Images to download: poster, backdrop and/or still (default: still for the rated episodes)")
+	imagesDownloadCmd.Flags().StringP("dir", "d", "images", "Destination directory")
+	imagesDownloadCmd.Flags().String("name-template", images.DefaultTemplate, "Template of the downloaded file names")
+	imagesDownloadCmd.Flags().String("manifest", "manifest.json", "Name of the manifest written in the destination directory")
+	imagesDownloadCmd.Flags().IntP("concurrency", "c", 4, "Maximum number of concurrent downloads")
+}
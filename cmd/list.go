@@ -0,0 +1,380 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/list"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var errNotConfirmed = errors.New("refusing to change the list without --confirm")
+
+// listCmd represents the list command
+var listCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "Manage custom lists",
+	Long:         "Manage custom lists. TMDB lists managed through this API only hold movies.",
+	SilenceUsage: true,
+}
+
+var listCreateCmd = &cobra.Command{
+	Use:          "create <name>",
+	Short:        "Create a new list",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		description, err := cmd.Flags().GetString("description")
+		if err != nil {
+			return err
+		}
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listCreateAction(stdout, apiRoot, args, description, opts)
+	},
+}
+
+func listCreateAction(out io.Writer, apiRoot string, args []string, description string, opts outputOptions) error {
+	url := fmt.Sprintf("%s/list", apiRoot)
+
+	// Lists only carry the ISO 639-1 part of the language, e.g. en for en-US
+	language, _, _ := strings.Cut(opts.language, "-")
+
+	resp, err := list.Create(url, args[0], description, language)
+	if err != nil {
+		return err
+	}
+
+	return render(out, opts, resp)
+}
+
+var listDeleteCmd = &cobra.Command{
+	Use:          "delete <list_id>",
+	Short:        "Delete a list",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		confirm, err := cmd.Flags().GetBool("confirm")
+		if err != nil {
+			return err
+		}
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listDeleteAction(stdout, apiRoot, args, confirm, opts)
+	},
+}
+
+func listDeleteAction(out io.Writer, apiRoot string, args []string, confirm bool, opts outputOptions) error {
+	if !confirm {
+		return errNotConfirmed
+	}
+
+	url := fmt.Sprintf("%s/list/%s", apiRoot, args[0])
+
+	resp, err := list.Delete(url)
+	if err != nil {
+		return err
+	}
+
+	return render(out, opts, resp)
+}
+
+var listShowCmd = &cobra.Command{
+	Use:          "show <list_id> [page]",
+	Short:        "Get the details of a list along with its items, from all the pages unless [page] is given",
+	SilenceUsage: true,
+	Args:         cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listShowAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func listShowAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	url := fmt.Sprintf("%s/list/%s", apiRoot, args[0])
+
+	// Without a page, all the pages of the list are walked
+	pages := func(fn func(*list.DetailsResponse) error) error {
+		return list.Pages(url, opts.language, fn)
+	}
+	if len(args) > 1 {
+		page, err := strconv.Atoi(args[1])
+		if err != nil {
+			return err
+		}
+		pages = func(fn func(*list.DetailsResponse) error) error {
+			resp, err := list.GetDetails(url, page, opts.language)
+			if err != nil {
+				return err
+			}
+			return fn(resp)
+		}
+	}
+
+	// Lists mix movies and TV shows, their genre ids don't overlap but for
+	// the genres having the same name
+	genres := genre.Names{}
+	if opts.needsGenres() {
+		for _, mediaType := range []string{"movie", "tv"} {
+			names, err := genre.Load(lookupCtx, apiRoot, mediaType, opts.language)
+			if err != nil {
+				return err
+			}
+			for id, name := range names {
+				genres[id] = name
+			}
+		}
+	}
+
+	match := func([]int) bool { return true }
+
+	return showMediaList(out, apiRoot, opts, genres, match, mediaList[*list.DetailsResponse, list.Item]{
+		get: func() (*list.DetailsResponse, error) {
+			var resp *list.DetailsResponse
+			err := pages(func(page *list.DetailsResponse) error {
+				if resp == nil {
+					resp = page
+				} else {
+					resp.Items = append(resp.Items, page.Items...)
+				}
+				return nil
+			})
+			return resp, err
+		},
+		pages:   pages,
+		results: func(resp *list.DetailsResponse) *[]list.Item { return &resp.Items },
+		print: func(out io.Writer, resp *list.DetailsResponse, from int) error {
+			return printListDetails(out, resp, from, opts.locale)
+		},
+		columns: listColumns,
+	})
+}
+
+func printListDetails(out io.Writer, resp *list.DetailsResponse, from int, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	if from == 0 {
+		loc.Fprintf(w, "List: %s\n", resp.Name)
+		loc.Fprintf(w, "Description: %s\n", resp.Description)
+		loc.Fprintf(w, "Created By: %s\n", resp.CreatedBy)
+		loc.Fprintf(w, "Total Items: %d\n", resp.ItemCount)
+		fmt.Fprint(w, loc.T("Items:\n"))
+	}
+	for i, r := range resp.Items {
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "ID: %d\n", r.ID)
+		loc.Fprintf(w, "Date: %s\n", loc.Date(r.Date()))
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	return w.Flush()
+}
+
+// listItemResult is the outcome of adding/removing one movie
+type listItemResult struct {
+	MediaID       int    `json:"media_id"`
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code,omitempty"`
+	StatusMessage string `json:"status_message"`
+}
+
+var listAddCmd = &cobra.Command{
+	Use:          "add <list_id> <movie_id>...",
+	Short:        "Add movies to a list",
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listItemsAction(stdout, apiRoot, args, list.AddItem, opts)
+	},
+}
+
+var listRemoveCmd = &cobra.Command{
+	Use:          "remove <list_id> <movie_id>...",
+	Short:        "Remove movies from a list",
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listItemsAction(stdout, apiRoot, args, list.RemoveItem, opts)
+	},
+}
+
+// listItemsAction applies change to every movie of args, it keeps going
+// when one of them fails and reports the failures once done
+func listItemsAction(out io.Writer, apiRoot string, args []string,
+	change func(url string, mediaID int) (*list.StatusResponse, error), opts outputOptions) error {
+
+	ids := make([]int, 0, len(args)-1)
+	for _, a := range args[1:] {
+		_, id, err := resolveID(apiRoot, "movie", a, opts.language)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	url := fmt.Sprintf("%s/list/%s", apiRoot, args[0])
+
+	var (
+		results = make([]listItemResult, 0, len(ids))
+		errs    []error
+	)
+	for _, id := range ids {
+		resp, err := change(url, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("movie %d: %w", id, err))
+			results = append(results, listItemResult{MediaID: id, StatusMessage: err.Error()})
+			continue
+		}
+
+		results = append(results, listItemResult{
+			MediaID:       id,
+			Success:       resp.Success,
+			StatusCode:    resp.StatusCode,
+			StatusMessage: resp.StatusMessage,
+		})
+	}
+
+	if err := render(out, opts, results); err != nil {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
+
+var listClearCmd = &cobra.Command{
+	Use:          "clear <list_id>",
+	Short:        "Remove all the items of a list",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		confirm, err := cmd.Flags().GetBool("confirm")
+		if err != nil {
+			return err
+		}
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listClearAction(stdout, apiRoot, args, confirm, opts)
+	},
+}
+
+func listClearAction(out io.Writer, apiRoot string, args []string, confirm bool, opts outputOptions) error {
+	if !confirm {
+		return errNotConfirmed
+	}
+
+	url := fmt.Sprintf("%s/list/%s", apiRoot, args[0])
+
+	resp, err := list.Clear(url)
+	if err != nil {
+		return err
+	}
+
+	return render(out, opts, resp)
+}
+
+var listStatusCmd = &cobra.Command{
+	Use:          "status <list_id> <movie_id>",
+	Short:        "Check whether a movie is part of a list",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return listStatusAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func listStatusAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, movieID, err := resolveID(apiRoot, "movie", args[1], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/list/%s", apiRoot, args[0])
+
+	resp, err := list.ItemStatus(url, movieID)
+	if err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	_, err = opts.locale.Fprintf(out, "Movie %d in list %s: %s\n", movieID, args[0], yesNo(resp.ItemPresent, opts.locale))
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+
+	listCmd.AddCommand(listCreateCmd)
+	listCmd.AddCommand(listDeleteCmd)
+	listCmd.AddCommand(listShowCmd)
+	listCmd.AddCommand(listAddCmd)
+	listCmd.AddCommand(listRemoveCmd)
+	listCmd.AddCommand(listClearCmd)
+	listCmd.AddCommand(listStatusCmd)
+
+	listCreateCmd.Flags().StringP("description", "d", "", "Description of the list")
+	listDeleteCmd.Flags().Bool("confirm", false, "Confirm the deletion of the list")
+	listClearCmd.Flags().Bool("confirm", false, "Confirm the removal of all the items")
+	listShowCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	listShowCmd.Flags().StringSlice("columns", nil, columnsUsage)
+	listShowCmd.Flags().String("sort-by", "", "Sort on a --columns field, field:desc for descending order")
+	listStatusCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
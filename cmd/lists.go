@@ -8,11 +8,11 @@ package cmd
 import (
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"text/tabwriter"
 
 	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/i18n"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,16 +26,16 @@ var listsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return listsAction(os.Stdout, apiRoot, args, isRaw)
+		return listsAction(stdout, apiRoot, args, opts)
 	},
 }
 
-func listsAction(out io.Writer, apiRoot string, args []string, isRaw bool) error {
+func listsAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
 	var (
 		page = 1
 		err  error
@@ -54,23 +54,30 @@ func listsAction(out io.Writer, apiRoot string, args []string, isRaw bool) error
 		return err
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
 	}
 
-	return printLists(out, resp)
+	return printLists(out, resp, opts.locale)
 }
 
-func printLists(out io.Writer, resp *account.ListsResponse) error {
+func printLists(out io.Writer, resp *account.ListsResponse, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
-	fmt.Fprint(w, "Lists:\n")
+	fmt.Fprint(w, loc.T("Lists:\n"))
 	for i, r := range results {
 		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Name: %s\n", r.Name)
-		fmt.Fprintf(w, "Description: %s\n", r.Description)
-		fmt.Fprintf(w, "List Type: %s\n", r.ListType)
-		fmt.Fprintf(w, "Total Items: %d\n\n", r.ItemCount)
+		loc.Fprintf(w, "Name: %s\n", r.Name)
+		loc.Fprintf(w, "Description: %s\n", r.Description)
+		loc.Fprintf(w, "List Type: %s\n", r.ListType)
+		if poster, ok := r.PosterPath.(string); ok {
+			loc.Fprintf(w, "Poster: %s\n", poster)
+		}
+		loc.Fprintf(w, "Total Items: %d\n\n", r.ItemCount)
 	}
 	return w.Flush()
 }
@@ -84,7 +91,7 @@ func init() {
 	// and all subcommands, e.g.:
 	// listsCmd.PersistentFlags().String("foo", "", "A help for foo")
 
-	listsCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
+	listsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
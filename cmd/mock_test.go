@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"testing"
 )
 
 var testResp = map[string]struct {
@@ -371,6 +374,74 @@ var testResp = map[string]struct {
   ],
   "total_pages": 1,
   "total_results": 2
+}`,
+	},
+	"resultsAddRating": {
+		Status: http.StatusCreated,
+		Body: `{
+  "success": true,
+  "status_code": 1,
+  "status_message": "Success."
+}`,
+	},
+	"resultsDeleteRating": {
+		Status: http.StatusOK,
+		Body: `{
+  "success": true,
+  "status_code": 13,
+  "status_message": "The item/record was deleted successfully."
+}`,
+	},
+	"resultsListDetails": {
+		Status: http.StatusOK,
+		Body: `{
+  "created_by": "clairvoyance27",
+  "description": "test my list",
+  "favorite_count": 0,
+  "id": "8521773",
+  "items": [
+    {
+      "adult": false,
+      "backdrop_path": "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg",
+      "genre_ids": [18],
+      "id": 550,
+      "media_type": "movie",
+      "original_language": "en",
+      "original_title": "Fight Club",
+      "overview": "A ticking-time-bomb insomniac and a slippery soap salesman channel primal male aggression into a shocking new form of therapy.",
+      "popularity": 33.7606,
+      "poster_path": "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg",
+      "release_date": "1999-10-15",
+      "title": "Fight Club",
+      "video": false,
+      "vote_average": 8.438,
+      "vote_count": 30142
+    },
+    {
+      "adult": false,
+      "backdrop_path": "/2w4xG178RpB4MDAIfTkqAuSJzec.jpg",
+      "genre_ids": [12, 28, 878],
+      "id": 11,
+      "media_type": "movie",
+      "original_language": "en",
+      "original_title": "Star Wars",
+      "overview": "Princess Leia is captured and held hostage by the evil Imperial forces in their effort to take over the galactic Empire.",
+      "popularity": 20.1525,
+      "poster_path": "/6FfCtAuVAW8XJjZ7eWeLibRLWTw.jpg",
+      "release_date": "1977-05-25",
+      "title": "Star Wars",
+      "video": false,
+      "vote_average": 8.203,
+      "vote_count": 21056
+    }
+  ],
+  "item_count": 2,
+  "iso_639_1": "en",
+  "name": "my-list",
+  "page": 1,
+  "poster_path": null,
+  "total_pages": 1,
+  "total_results": 2
 }`,
 	},
 	"resultsGetRatedEpisodes": {
@@ -415,6 +486,227 @@ var testResp = map[string]struct {
   "total_results": 2
 }`,
 	},
+	"resultsPersonDetails": {
+		Status: http.StatusOK,
+		Body: `{
+  "adult": false,
+  "also_known_as": [],
+  "biography": "David Fincher is an American film director.",
+  "birthday": "1962-08-28",
+  "deathday": null,
+  "gender": 2,
+  "homepage": null,
+  "id": 7467,
+  "imdb_id": "nm0000399",
+  "known_for_department": "Directing",
+  "name": "David Fincher",
+  "place_of_birth": "Denver, Colorado, USA",
+  "popularity": 5.6712,
+  "profile_path": "/tpEczFclQZeKAiCeKZZ0adRvtfz.jpg"
+}`,
+	},
+	"resultsPersonCredits": {
+		Status: http.StatusOK,
+		Body: `{
+  "cast": [
+    {
+      "adult": false,
+      "backdrop_path": null,
+      "genre_ids": [99],
+      "id": 4248,
+      "original_language": "en",
+      "original_title": "Visions of Light",
+      "overview": "A documentary about cinematography.",
+      "popularity": 1.2,
+      "poster_path": null,
+      "release_date": "1992-04-02",
+      "title": "Visions of Light",
+      "video": false,
+      "vote_average": 7.1,
+      "vote_count": 40,
+      "character": "Himself",
+      "credit_id": "52fe43c1c3a36847f806e95b",
+      "order": 0,
+      "media_type": "movie"
+    }
+  ],
+  "crew": [
+    {
+      "adult": false,
+      "backdrop_path": "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg",
+      "genre_ids": [18],
+      "id": 550,
+      "original_language": "en",
+      "original_title": "Fight Club",
+      "overview": "A ticking-time-bomb insomniac and a slippery soap salesman channel primal male aggression into a shocking new form of therapy.",
+      "popularity": 33.7606,
+      "poster_path": "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg",
+      "release_date": "1999-10-15",
+      "title": "Fight Club",
+      "video": false,
+      "vote_average": 8.438,
+      "vote_count": 30142,
+      "credit_id": "52fe4250c3a36847f80149f3",
+      "department": "Directing",
+      "job": "Director",
+      "media_type": "movie"
+    },
+    {
+      "adult": false,
+      "backdrop_path": "/rSPw7tgCH9c6NqICZef4kZjFOQ5.jpg",
+      "genre_ids": [80, 18],
+      "id": 67744,
+      "origin_country": ["US"],
+      "original_language": "en",
+      "original_name": "Mindhunter",
+      "overview": "An agent in the FBI's Elite Serial Crime Unit develops profiling techniques.",
+      "popularity": 40.1,
+      "poster_path": "/fbKE87mojpIETWepSbD5Qt741fp.jpg",
+      "first_air_date": "2017-10-13",
+      "name": "Mindhunter",
+      "vote_average": 8.1,
+      "vote_count": 2300,
+      "credit_id": "58d2c9a5c3a3683a7a00f2c1",
+      "department": "Production",
+      "job": "Executive Producer",
+      "episode_count": 19,
+      "media_type": "tv"
+    },
+    {
+      "adult": false,
+      "backdrop_path": "/sXPbN8VkG0SBhGGqTjbLWSZQpDq.jpg",
+      "genre_ids": [80, 9648, 53],
+      "id": 807,
+      "original_language": "en",
+      "original_title": "Se7en",
+      "overview": "Two homicide detectives are on a desperate hunt for a serial killer.",
+      "popularity": 21.3,
+      "poster_path": "/191nKfP0ehp3uIvWqgPbFmI4lv9.jpg",
+      "release_date": "1995-09-22",
+      "title": "Se7en",
+      "video": false,
+      "vote_average": 8.371,
+      "vote_count": 21000,
+      "credit_id": "52fe4279c3a36847f8020b79",
+      "department": "Directing",
+      "job": "Director",
+      "media_type": "movie"
+    }
+  ],
+  "id": 7467
+}`,
+	},
+}
+
+var testMovieDetails = `{
+  "adult": false,
+  "backdrop_path": "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg",
+  "belongs_to_collection": null,
+  "budget": 63000000,
+  "genres": [{"id": 18, "name": "Drama"}],
+  "homepage": "http://www.foxmovies.com/movies/fight-club",
+  "id": 550,
+  "imdb_id": "tt0137523",
+  "original_language": "en",
+  "original_title": "Fight Club",
+  "overview": "A ticking-time-bomb insomniac and a slippery soap salesman channel primal male aggression into a shocking new form of therapy.",
+  "popularity": 33.7606,
+  "poster_path": "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg",
+  "release_date": "1999-10-15",
+  "revenue": 100853753,
+  "runtime": 139,
+  "status": "Released",
+  "tagline": "Mischief. Mayhem. Soap.",
+  "title": "Fight Club",
+  "video": false,
+  "vote_average": 8.438,
+  "vote_count": 30142
+}`
+
+var referenceResp = map[string]string{
+	"/configuration": `{
+  "change_keys": ["adult", "images", "release_dates"],
+  "images": {
+    "base_url": "http://image.tmdb.org/t/p/",
+    "secure_base_url": "https://image.tmdb.org/t/p/",
+    "backdrop_sizes": ["w300", "w780", "w1280", "original"],
+    "logo_sizes": ["w45", "w92", "w154", "w185", "w300", "w500", "original"],
+    "poster_sizes": ["w92", "w154", "w185", "w342", "w500", "w780", "original"],
+    "profile_sizes": ["w45", "w185", "h632", "original"],
+    "still_sizes": ["w92", "w185", "w300", "original"]
+  }
+}`,
+	"/genre/movie/list": `{
+  "genres": [
+    {"id": 28, "name": "Action"},
+    {"id": 12, "name": "Adventure"},
+    {"id": 16, "name": "Animation"},
+    {"id": 35, "name": "Comedy"},
+    {"id": 80, "name": "Crime"},
+    {"id": 99, "name": "Documentary"},
+    {"id": 18, "name": "Drama"},
+    {"id": 10751, "name": "Family"},
+    {"id": 14, "name": "Fantasy"},
+    {"id": 36, "name": "History"},
+    {"id": 27, "name": "Horror"},
+    {"id": 10402, "name": "Music"},
+    {"id": 9648, "name": "Mystery"},
+    {"id": 10749, "name": "Romance"},
+    {"id": 878, "name": "Science Fiction"},
+    {"id": 10770, "name": "TV Movie"},
+    {"id": 53, "name": "Thriller"},
+    {"id": 10752, "name": "War"},
+    {"id": 37, "name": "Western"}
+  ]
+}`,
+	"/genre/tv/list": `{
+  "genres": [
+    {"id": 10759, "name": "Action & Adventure"},
+    {"id": 16, "name": "Animation"},
+    {"id": 35, "name": "Comedy"},
+    {"id": 80, "name": "Crime"},
+    {"id": 99, "name": "Documentary"},
+    {"id": 18, "name": "Drama"},
+    {"id": 10751, "name": "Family"},
+    {"id": 10762, "name": "Kids"},
+    {"id": 9648, "name": "Mystery"},
+    {"id": 10763, "name": "News"},
+    {"id": 10764, "name": "Reality"},
+    {"id": 10765, "name": "Sci-Fi & Fantasy"},
+    {"id": 10766, "name": "Soap"},
+    {"id": 10767, "name": "Talk"},
+    {"id": 10768, "name": "War & Politics"},
+    {"id": 37, "name": "Western"}
+  ]
+}`,
+}
+
+// serveReference answers the genre lists and configuration requests, it
+// reports whether the request was handled
+func serveReference(w http.ResponseWriter, r *http.Request) bool {
+	body, ok := referenceResp[r.URL.Path]
+	if !ok {
+		return false
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, body)
+	return true
+}
+
+// TestMain keeps the tests away from the user cache directory
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "tmdbCLI-cache")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Setenv("TMDB_CACHE_DIR", dir)
+
+	code := m.Run()
+
+	os.RemoveAll(dir)
+	os.Exit(code)
 }
 
 func mockServer(h http.HandlerFunc) (string, func()) {
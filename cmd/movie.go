@@ -0,0 +1,262 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"example.com/dummyheaad/tmdbCLI/tv"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// movieCmd represents the movie command
+var movieCmd = &cobra.Command{
+	Use:          "movie",
+	Short:        "TMDB API for movies",
+	SilenceUsage: true,
+}
+
+var movieKeywordsCmd = &cobra.Command{
+	Use:          "keywords <movie_id>",
+	Short:        "Get the keywords of a movie",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return keywordsAction(stdout, apiRoot, "movie", args, opts)
+	},
+}
+
+var movieAltTitlesCmd = &cobra.Command{
+	Use:          "alt-titles <movie_id>",
+	Short:        "Get the alternative titles of a movie, only the ones of --region when given",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+		region := viper.GetString("region")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return altTitlesAction(stdout, apiRoot, "movie", args, region, opts)
+	},
+}
+
+var movieTranslationsCmd = &cobra.Command{
+	Use:          "translations <movie_id>",
+	Short:        "Get the translations of a movie",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return translationsAction(stdout, apiRoot, "movie", args, opts)
+	},
+}
+
+// keywordsAction prints the keywords of the movie or TV show of args
+func keywordsAction(out io.Writer, apiRoot, mediaType string, args []string, opts outputOptions) error {
+	url, err := mediaURL(apiRoot, []string{mediaType, args[0]}, opts.language)
+	if err != nil {
+		return err
+	}
+
+	var (
+		resp  any
+		id    int
+		names []string
+	)
+
+	if mediaType == "movie" {
+		r, err := movie.GetKeywords(url)
+		if err != nil {
+			return err
+		}
+		resp, id = r, r.ID
+		for _, k := range r.Keywords {
+			names = append(names, k.Name)
+		}
+	} else {
+		r, err := tv.GetKeywords(url)
+		if err != nil {
+			return err
+		}
+		resp, id = r, r.ID
+		for _, k := range r.Results {
+			names = append(names, k.Name)
+		}
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	opts.locale.Fprintf(w, "Keywords for %s %d\n", mediaType, id)
+	for i, name := range names {
+		fmt.Fprintf(w, "%d. %s\n", i+1, name)
+	}
+	return w.Flush()
+}
+
+// altTitle is an alternative title of a movie or TV show
+type altTitle struct {
+	country string
+	title   string
+	kind    string
+}
+
+// altTitlesAction prints the alternative titles of the movie or TV show of
+// args, the ones of region only when it isn't empty
+func altTitlesAction(out io.Writer, apiRoot, mediaType string, args []string, region string, opts outputOptions) error {
+	url, err := mediaURL(apiRoot, []string{mediaType, args[0]}, opts.language)
+	if err != nil {
+		return err
+	}
+
+	region = strings.ToUpper(region)
+
+	var (
+		resp   any
+		id     int
+		titles []altTitle
+	)
+
+	if mediaType == "movie" {
+		r, err := movie.GetAltTitles(url, region)
+		if err != nil {
+			return err
+		}
+		resp, id = r, r.ID
+		for _, t := range r.Titles {
+			titles = append(titles, altTitle{t.ISO_3166_1, t.Title, t.Type})
+		}
+	} else {
+		r, err := tv.GetAltTitles(url)
+		if err != nil {
+			return err
+		}
+
+		// The TV endpoint has no country filter
+		if region != "" {
+			results := r.Results[:0]
+			for _, t := range r.Results {
+				if t.ISO_3166_1 == region {
+					results = append(results, t)
+				}
+			}
+			r.Results = results
+		}
+
+		resp, id = r, r.ID
+		for _, t := range r.Results {
+			titles = append(titles, altTitle{t.ISO_3166_1, t.Title, t.Type})
+		}
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	opts.locale.Fprintf(w, "Alternative titles for %s %d\n", mediaType, id)
+	fmt.Fprint(w, opts.locale.T("Country\tTitle\tType\n"))
+	for _, t := range titles {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.country, t.title, dash(t.kind))
+	}
+	return w.Flush()
+}
+
+// localizedTitle is the title of a movie or TV show in one language
+type localizedTitle struct {
+	language string
+	name     string
+	title    string
+}
+
+// translationsAction prints the title of the movie or TV show of args in
+// every language it is translated to, starting with the configured one
+func translationsAction(out io.Writer, apiRoot, mediaType string, args []string, opts outputOptions) error {
+	url, err := mediaURL(apiRoot, []string{mediaType, args[0]}, opts.language)
+	if err != nil {
+		return err
+	}
+
+	var (
+		resp   any
+		id     int
+		titles []localizedTitle
+	)
+
+	if mediaType == "movie" {
+		r, err := movie.GetTranslations(url)
+		if err != nil {
+			return err
+		}
+		resp, id = r, r.ID
+		for _, t := range r.Translations {
+			titles = append(titles, localizedTitle{t.Language(), t.EnglishName, t.Data.Title})
+		}
+	} else {
+		r, err := tv.GetTranslations(url)
+		if err != nil {
+			return err
+		}
+		resp, id = r, r.ID
+		for _, t := range r.Translations {
+			titles = append(titles, localizedTitle{t.Language(), t.EnglishName, t.Data.Name})
+		}
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	opts.locale.Fprintf(w, "Translations for %s %d\n", mediaType, id)
+	for _, t := range titles {
+		if strings.EqualFold(t.language, opts.language) && t.title != "" {
+			opts.locale.Fprintf(w, "Title (%s): %s\n", t.language, t.title)
+			break
+		}
+	}
+	fmt.Fprint(w, opts.locale.T("Language\tName\tTitle\n"))
+	for _, t := range titles {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.language, t.name, dash(t.title))
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(movieCmd)
+
+	movieCmd.AddCommand(movieKeywordsCmd)
+	movieCmd.AddCommand(movieAltTitlesCmd)
+	movieCmd.AddCommand(movieTranslationsCmd)
+
+	movieKeywordsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+	movieAltTitlesCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+	movieTranslationsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/network"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// networkCmd represents the network command
+var networkCmd = &cobra.Command{
+	Use:          "network",
+	Short:        "TMDB API for TV networks",
+	SilenceUsage: true,
+}
+
+var networkDetailsCmd = &cobra.Command{
+	Use:          "details <network_id>",
+	Short:        "Get the details of a TV network",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return networkDetailsAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func networkDetailsAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, networkID, err := resolveID(apiRoot, "network", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/network/%d", apiRoot, networkID)
+
+	resp, err := network.GetDetails(url)
+	if err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	opts.locale.Fprintf(w, "Network details for %d\n", resp.ID)
+	opts.locale.Fprintf(w, "Name: %s\n", resp.Name)
+	opts.locale.Fprintf(w, "Headquarters: %s\n", resp.Headquarters)
+	opts.locale.Fprintf(w, "Origin Country: %s\n", resp.OriginCountry)
+	opts.locale.Fprintf(w, "Homepage: %s\n", resp.Homepage)
+	opts.locale.Fprintf(w, "Logo: %s\n", resp.LogoPath)
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(networkCmd)
+
+	networkCmd.AddCommand(networkDetailsCmd)
+
+	networkDetailsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
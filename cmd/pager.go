@@ -0,0 +1,189 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"example.com/dummyheaad/tmdbCLI/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultPager is run when neither $TMDB_PAGER nor $PAGER are set
+const defaultPager = "less -R"
+
+// errPagerQuit is returned by the writes following the pager exit, to stop
+// the commands printing and fetching results nobody reads. It isn't
+// reported as an error
+var errPagerQuit = errors.New("pager quit")
+
+// stdout is where the commands print, the pager once startPager has been
+// called
+var stdout io.Writer = os.Stdout
+
+// pager holds the output back until it exceeds the screen height, and only
+// then runs the pager command to show it. Shorter outputs are printed as
+// they are
+type pager struct {
+	command string
+	height  int
+	out     io.Writer
+
+	buf   bytes.Buffer
+	lines int
+
+	cmd *exec.Cmd
+	in  io.WriteCloser
+
+	// direct is set once the output goes to out, the pager being unneeded
+	// or failing to start, and quit once the pager has been left
+	direct bool
+	quit   bool
+}
+
+// pageOutput reports whether the output of cmd is paged. Only the human
+// readable output printed to a terminal is, the other formats being for
+// other programs
+func pageOutput(cmd *cobra.Command, terminal bool) bool {
+	if !terminal || viper.GetString("template") != "" || viper.GetString("query") != "" {
+		return false
+	}
+	if raw, err := cmd.Flags().GetBool("raw"); err == nil && raw {
+		return false
+	}
+
+	format := viper.GetString("output")
+	return format == "" || format == output.Text
+}
+
+// startPager sends the output of the command to the pager, unless it is
+// turned off with --no-pager or TMDB_NO_PAGER
+func startPager() {
+	if viper.GetBool("no-pager") {
+		return
+	}
+
+	command := viper.GetString("pager")
+	if command == "" {
+		command = os.Getenv("PAGER")
+	}
+	if command == "" {
+		command = defaultPager
+	}
+
+	stdout = &pager{
+		command: command,
+		height:  terminalHeight(os.Stdout),
+		out:     os.Stdout,
+	}
+}
+
+// stopPager flushes the output and waits for the pager to be left
+func stopPager() error {
+	p, ok := stdout.(*pager)
+	if !ok {
+		return nil
+	}
+	stdout = os.Stdout
+
+	return p.Close()
+}
+
+func (p *pager) Write(b []byte) (int, error) {
+	switch {
+	case p.quit:
+		return 0, errPagerQuit
+	case p.direct:
+		return p.out.Write(b)
+	case p.in != nil:
+		if _, err := p.in.Write(b); err != nil {
+			p.quit = true
+			return 0, errPagerQuit
+		}
+		return len(b), nil
+	}
+
+	p.buf.Write(b)
+	p.lines += bytes.Count(b, []byte("\n"))
+
+	// The last line of the screen is kept for the prompt
+	if p.lines < p.height {
+		return len(b), nil
+	}
+
+	if err := p.start(); err != nil {
+		p.direct = true
+	}
+	if _, err := p.flush(); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// start runs the pager command, reading from p.in
+func (p *pager) start() error {
+	args := strings.Fields(p.command)
+	if len(args) == 0 || args[0] == "cat" {
+		return exec.ErrNotFound
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = p.out
+	cmd.Stderr = os.Stderr
+
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	p.cmd, p.in = cmd, in
+	return nil
+}
+
+// flush writes the output held back so far
+func (p *pager) flush() (int, error) {
+	defer p.buf.Reset()
+
+	if p.direct {
+		return p.out.Write(p.buf.Bytes())
+	}
+	if _, err := p.in.Write(p.buf.Bytes()); err != nil {
+		p.quit = true
+		return 0, errPagerQuit
+	}
+	return p.buf.Len(), nil
+}
+
+// Close prints the output held back, or waits for the pager to be left.
+// Leaving the pager before the end of the output isn't an error, the pager
+// failing is
+func (p *pager) Close() error {
+	if p.in == nil {
+		p.direct = true
+		_, err := p.flush()
+		return err
+	}
+
+	closeErr := p.in.Close()
+	if err := p.cmd.Wait(); err != nil {
+		return fmt.Errorf("pager %q: %w", p.command, err)
+	}
+	if p.quit {
+		return nil
+	}
+	return closeErr
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import "sync"
+
+// lookupConcurrency is the maximum number of requests sent at once for the
+// entries of a list, e.g. to get their providers or release dates
+const lookupConcurrency = 4
+
+// eachParallel calls fn for every index of 0 to n-1, running at most
+// lookupConcurrency calls at once. It returns the error of the first index
+// failing once all the calls are done
+func eachParallel(n int, fn func(i int) error) error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, lookupConcurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/person"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// personCmd represents the person command
+var personCmd = &cobra.Command{
+	Use:          "person",
+	Short:        "TMDB API for people",
+	SilenceUsage: true,
+}
+
+var personDetailsCmd = &cobra.Command{
+	Use:          "details <person_id>",
+	Short:        "Get the details of a person",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return personDetailsAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func personDetailsAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	_, personID, err := resolveID(apiRoot, "person", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/person/%d", apiRoot, personID)
+
+	resp, err := person.GetDetails(url, opts.language)
+	if err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printPersonDetails(out, resp, opts.locale)
+}
+
+func printPersonDetails(out io.Writer, resp *person.DetailsResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Person details for %d\n", resp.ID)
+	loc.Fprintf(w, "Name: %s\n", resp.Name)
+	loc.Fprintf(w, "Known For: %s\n", resp.KnownForDepartment)
+	loc.Fprintf(w, "Birthday: %s\n", loc.Date(resp.Birthday))
+	if resp.Deathday != "" {
+		loc.Fprintf(w, "Deathday: %s\n", loc.Date(resp.Deathday))
+	}
+	loc.Fprintf(w, "Place of Birth: %s\n", resp.PlaceOfBirth)
+	loc.Fprintf(w, "Popularity: %s\n", loc.Float(resp.Popularity, 2))
+	loc.Fprintf(w, "Profile: %s\n", resp.ProfilePath)
+	loc.Fprintf(w, "Biography: %s\n", resp.Biography)
+	return w.Flush()
+}
+
+// creditsOptions holds the filters applied to a person credits listing
+type creditsOptions struct {
+	mediaType    string
+	department   string
+	job          string
+	sortBy       string
+	excludeRated bool
+}
+
+var personCreditsCmd = &cobra.Command{
+	Use:   "credits <person_id>",
+	Short: "Get the movie, tv or combined credits of a person",
+	Long: `Get the movie, tv or combined credits of a person.
+
+Cast entries belong to the "Acting" department. Use --department and --job to
+narrow down the crew entries, e.g. --job Director. The titles rated by your
+account show your rating, use --exclude-rated to hide them.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		var filter creditsOptions
+
+		if filter.mediaType, err = cmd.Flags().GetString("media"); err != nil {
+			return err
+		}
+		if filter.department, err = cmd.Flags().GetString("department"); err != nil {
+			return err
+		}
+		if filter.job, err = cmd.Flags().GetString("job"); err != nil {
+			return err
+		}
+		if filter.sortBy, err = cmd.Flags().GetString("sort-by"); err != nil {
+			return err
+		}
+		// Rejected before the credits are fetched
+		if filter.sortBy != "date" && filter.sortBy != "popularity" {
+			return person.ErrInvalidSort
+		}
+		if filter.excludeRated, err = cmd.Flags().GetBool("exclude-rated"); err != nil {
+			return err
+		}
+
+		return personCreditsAction(stdout, apiRoot, args, filter, opts)
+	},
+}
+
+func personCreditsAction(out io.Writer, apiRoot string, args []string, filter creditsOptions, opts outputOptions) error {
+	_, personID, err := resolveID(apiRoot, "person", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	switch filter.mediaType {
+	case "movie", "tv", "combined":
+	default:
+		return errors.New("invalid --media value")
+	}
+
+	url := fmt.Sprintf("%s/person/%d", apiRoot, personID)
+
+	resp, err := person.GetCredits(url, filter.mediaType, opts.language)
+	if err != nil {
+		return err
+	}
+
+	// The text output shows the ratings of the account along the credits,
+	// answering what is left to watch at a glance. Only --exclude-rated
+	// needs them, the credits are printed without them when they fail
+	var ratings map[string]float64
+	if filter.excludeRated {
+		if ratings, err = getRatings(apiRoot); err != nil {
+			return err
+		}
+	} else if opts.human() {
+		ratings, _ = getRatings(apiRoot)
+	}
+	// Only the combined credits tell the media type of each entry
+	rating := func(mediaType string, id int) (float64, bool) {
+		if filter.mediaType != "combined" {
+			mediaType = filter.mediaType
+		}
+		r, ok := ratings[ratedKey(mediaType, id)]
+		return r, ok
+	}
+
+	if filter.excludeRated {
+		resp.Exclude(func(mediaType string, id int) bool {
+			_, ok := rating(mediaType, id)
+			return ok
+		})
+	}
+
+	resp.Filter(filter.department, filter.job)
+	if err := resp.Sort(filter.sortBy); err != nil {
+		return err
+	}
+
+	if err := resolveImages(apiRoot, opts, resp); err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printCredits(out, resp, rating, opts.locale)
+}
+
+func printCredits(out io.Writer, resp *person.CreditsResponse, rating func(mediaType string, id int) (float64, bool), loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Credits for %d\n", resp.ID)
+	fmt.Fprint(w, loc.T("Cast:\n"))
+	for i, r := range resp.Cast {
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "Media Type: %s\n", r.MediaType)
+		loc.Fprintf(w, "Character: %s\n", r.Character)
+		loc.Fprintf(w, "Date: %s\n", loc.Date(r.Date()))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		if v, ok := rating(r.MediaType, r.ID); ok {
+			loc.Fprintf(w, "Rating: %s\n", loc.Float(v, 1))
+		}
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	fmt.Fprint(w, loc.T("Crew:\n"))
+	for i, r := range resp.Crew {
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "Media Type: %s\n", r.MediaType)
+		loc.Fprintf(w, "Department: %s\n", r.Department)
+		loc.Fprintf(w, "Job: %s\n", r.Job)
+		loc.Fprintf(w, "Date: %s\n", loc.Date(r.Date()))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		if v, ok := rating(r.MediaType, r.ID); ok {
+			loc.Fprintf(w, "Rating: %s\n", loc.Float(v, 1))
+		}
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(personCmd)
+
+	personCmd.AddCommand(personDetailsCmd)
+	personCmd.AddCommand(personCreditsCmd)
+
+	personDetailsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+
+	personCreditsCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	personCreditsCmd.Flags().StringP("media", "m", "combined", "Credits to fetch: movie, tv or combined")
+	personCreditsCmd.Flags().String("department", "", "Only show credits in this department, e.g. Directing")
+	personCreditsCmd.Flags().String("job", "", "Only show crew credits with this job, e.g. Director")
+	personCreditsCmd.Flags().String("sort-by", "date", "Sort credits by date or popularity")
+	personCreditsCmd.Flags().Bool("exclude-rated", false, "Hide movies/tv shows already rated by your account")
+}
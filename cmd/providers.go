@@ -0,0 +1,304 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/provider"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// offerTypes lists the offers grouped by the providers commands along with
+// their labels. The optional ones, seldom offered, are only printed when
+// they have providers
+var offerTypes = []struct {
+	name     string
+	label    string
+	optional bool
+}{
+	{"flatrate", "Stream", false},
+	{"rent", "Rent", false},
+	{"buy", "Buy", false},
+	{"free", "Free", true},
+	{"ads", "Ads", true},
+}
+
+// providerOptions holds the region and services to look the providers up for
+type providerOptions struct {
+	region   string
+	services []string
+}
+
+func getProviderOptions(cmd *cobra.Command) (providerOptions, error) {
+	var (
+		opts providerOptions
+		err  error
+	)
+
+	opts.region = viper.GetString("region")
+
+	// Each providers command has its own --service, which can't be bound to
+	// a single viper key, so the flag is read as is and only falls back to
+	// TMDB_SERVICE, bound in the root init and comma separated like the flag
+	if cmd.Flags().Changed("service") {
+		if opts.services, err = cmd.Flags().GetStringSlice("service"); err != nil {
+			return opts, err
+		}
+	} else if services := viper.GetString("service"); services != "" {
+		if opts.services, err = csv.NewReader(strings.NewReader(services)).Read(); err != nil {
+			return opts, fmt.Errorf("invalid TMDB_SERVICE value: %w", err)
+		}
+	}
+
+	return opts, nil
+}
+
+// resolveRegion returns region, or the country of the account when it is
+// empty
+func resolveRegion(apiRoot, region string) (string, error) {
+	if region != "" {
+		return strings.ToUpper(region), nil
+	}
+
+	resp, err := account.GetDetailsContext(lookupCtx, fmt.Sprintf("%s/account/null", apiRoot))
+	if err != nil {
+		return "", err
+	}
+
+	if resp.ISO_3166_1 == "" {
+		return "", errors.New("no region configured, use --region")
+	}
+
+	return resp.ISO_3166_1, nil
+}
+
+// providersCmd represents the providers command
+var providersCmd = &cobra.Command{
+	Use:          "providers <media_type> <media_id>",
+	Short:        "Get where a movie or TV show can be streamed, rented or bought\n<media_type>: movie or tv",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		popts, err := getProviderOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return providersAction(stdout, apiRoot, args, popts, opts)
+	},
+}
+
+func providersAction(out io.Writer, apiRoot string, args []string, popts providerOptions, opts outputOptions) error {
+	url, err := mediaURL(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	region, err := resolveRegion(apiRoot, popts.region)
+	if err != nil {
+		return err
+	}
+
+	resp, err := provider.Get(url)
+	if err != nil {
+		return err
+	}
+
+	resp.Filter(region, popts.services)
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printProviders(out, args[0], region, resp, opts.locale)
+}
+
+func printProviders(out io.Writer, mediaType, region string, resp *provider.Response, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Watch providers for %s %d in %s\n", mediaType, resp.ID, region)
+	for _, o := range offerTypes {
+		names := resp.Names(region, o.name)
+		if o.optional && len(names) == 0 {
+			continue
+		}
+		loc.Fprintf(w, "%s: %s\n", loc.T(o.label), strings.Join(names, ", "))
+	}
+	if link := resp.Results[region].Link; link != "" {
+		loc.Fprintf(w, "Link: %s\n", link)
+	}
+	return w.Flush()
+}
+
+// watchlistTitle identifies a watchlist entry in the providers grouping
+type watchlistTitle struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// watchlistProviders groups the watchlist by offer type and provider name
+type watchlistProviders struct {
+	Region      string                                 `json:"region"`
+	Offers      map[string]map[string][]watchlistTitle `json:"offers"`
+	Unavailable []watchlistTitle                       `json:"unavailable"`
+}
+
+var watchlistProvidersCmd = &cobra.Command{
+	Use:          "providers <media_type>",
+	Short:        "Group the watchlist by the services streaming, renting, selling or offering it for free\n<media_type>: movies or tv",
+	SilenceUsage: true,
+	Args:         cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:    []string{"movies", "tv"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		popts, err := getProviderOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return watchlistProvidersAction(stdout, apiRoot, args, popts, opts)
+	},
+}
+
+func watchlistProvidersAction(out io.Writer, apiRoot string, args []string, popts providerOptions, opts outputOptions) error {
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	region, err := resolveRegion(apiRoot, popts.region)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mediaType string
+		titles    []watchlistTitle
+	)
+
+	if args[0] == "movies" {
+		mediaType = "movie"
+		resp, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, args[0], opts.language)
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Results {
+			titles = append(titles, watchlistTitle{r.ID, r.DisplayTitle()})
+		}
+	} else {
+		mediaType = "tv"
+		resp, err := account.GetWatchlist[*account.WatchlistTvResponse](url, args[0], opts.language)
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Results {
+			titles = append(titles, watchlistTitle{r.ID, r.DisplayName()})
+		}
+	}
+
+	groups := watchlistProviders{
+		Region:      region,
+		Offers:      map[string]map[string][]watchlistTitle{},
+		Unavailable: []watchlistTitle{},
+	}
+	for _, o := range offerTypes {
+		groups.Offers[o.name] = map[string][]watchlistTitle{}
+	}
+
+	// The providers are looked up concurrently, then grouped in the order
+	// of the watchlist
+	found := make([]*provider.Response, len(titles))
+	err = eachParallel(len(titles), func(i int) error {
+		resp, err := provider.Get(fmt.Sprintf("%s/%s/%d", apiRoot, mediaType, titles[i].ID))
+		found[i] = resp
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, t := range titles {
+		resp := found[i]
+		resp.Filter(region, popts.services)
+
+		available := false
+		for _, o := range offerTypes {
+			for _, name := range resp.Names(region, o.name) {
+				groups.Offers[o.name][name] = append(groups.Offers[o.name][name], t)
+				available = true
+			}
+		}
+
+		if !available {
+			groups.Unavailable = append(groups.Unavailable, t)
+		}
+	}
+
+	if !opts.human() {
+		return render(out, opts, groups)
+	}
+
+	return printWatchlistProviders(out, groups, opts.locale)
+}
+
+func printWatchlistProviders(out io.Writer, groups watchlistProviders, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Watchlist providers in %s\n", groups.Region)
+	for _, o := range offerTypes {
+		services := groups.Offers[o.name]
+
+		names := make([]string, 0, len(services))
+		for name := range services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if o.optional && len(names) == 0 {
+			continue
+		}
+		loc.Fprintf(w, "%s:\n", loc.T(o.label))
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s:\n", name)
+			for _, t := range services[name] {
+				fmt.Fprintf(w, "  - %s\n", t.Title)
+			}
+		}
+	}
+	fmt.Fprint(w, loc.T("Not Available:\n"))
+	for _, t := range groups.Unavailable {
+		fmt.Fprintf(w, "  - %s\n", t.Title)
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(providersCmd)
+	watchlistCmd.AddCommand(watchlistProvidersCmd)
+
+	providersCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	providersCmd.Flags().StringSlice("service", nil, "Only show these services, e.g. Netflix,\"Disney Plus\", same as TMDB_SERVICE")
+	watchlistProvidersCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	watchlistProvidersCmd.Flags().StringSlice("service", nil, "Only show these services, e.g. Netflix,\"Disney Plus\", same as TMDB_SERVICE")
+}
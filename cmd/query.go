@@ -0,0 +1,64 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+	"example.com/dummyheaad/tmdbCLI/output"
+)
+
+// renderQuery prints the outputs of --query run against resp, or against
+// the TMDB responses with --query-source server
+func renderQuery(out io.Writer, opts outputOptions, resp any) error {
+	var (
+		input any
+		err   error
+	)
+
+	if opts.querySource == "server" {
+		input, err = serverResponse()
+	} else {
+		input, err = output.Value(resp)
+	}
+	if err != nil {
+		return err
+	}
+
+	results, err := opts.query.Run(input)
+	if err != nil {
+		return fmt.Errorf("--query %q: %w", opts.query, err)
+	}
+
+	return output.RenderResults(out, opts.outputFormat(), results, opts.renderOptions())
+}
+
+// serverResponse returns the TMDB responses received while running the
+// command, as they were sent: a single document, or an array of them when
+// the command made several requests
+func serverResponse() (any, error) {
+	var docs []any
+
+	for _, r := range client.Recorded() {
+		doc, err := output.Decode(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.URL, err)
+		}
+		docs = append(docs, doc)
+	}
+
+	switch len(docs) {
+	case 0:
+		return nil, errors.New("no response received from TMDB")
+	case 1:
+		return docs[0], nil
+	}
+
+	return docs, nil
+}
@@ -6,12 +6,21 @@ Check LICENSE for details.
 package cmd
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"text/tabwriter"
+	"time"
 
 	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -32,69 +41,84 @@ var getRatedCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return getRatedAction(os.Stdout, apiRoot, args, isRaw)
+		return getRatedAction(stdout, apiRoot, args, opts)
 	},
 }
 
-func getRatedAction(out io.Writer, apiRoot string, args []string, isRaw bool) error {
+func getRatedAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
 	url := fmt.Sprintf("%s/account/null", apiRoot)
 
 	mediaType := args[0]
 
-	if mediaType == "movies" {
-		resp, err := account.GetRatedShow[*account.RatedMoviesResponse](url, mediaType)
-		if err != nil {
-			return err
-		}
-
-		if isRaw {
-			return printResp(out, resp)
-		}
-
-		return printRatedMovies(out, resp)
-	}
-
-	resp, err := account.GetRatedShow[*account.RatedTvResponse](url, mediaType)
+	genres, match, err := loadGenres(apiRoot, mediaType, opts)
 	if err != nil {
 		return err
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	if mediaType == "movies" {
+		return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.RatedMoviesResponse, account.RatedMovie]{
+			get: func() (*account.RatedMoviesResponse, error) {
+				return account.GetRatedShow[*account.RatedMoviesResponse](url, mediaType, opts.language)
+			},
+			pages: func(fn func(*account.RatedMoviesResponse) error) error {
+				return account.RatedShowPages(url, mediaType, opts.language, fn)
+			},
+			results: func(resp *account.RatedMoviesResponse) *[]account.RatedMovie { return &resp.Results },
+			print: func(out io.Writer, resp *account.RatedMoviesResponse, from int) error {
+				return printRatedMovies(out, resp, genres, from, opts.locale)
+			},
+			columns: ratedColumns,
+		})
 	}
 
-	return printRatedTv(out, resp)
+	return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.RatedTvResponse, account.RatedTv]{
+		get: func() (*account.RatedTvResponse, error) {
+			return account.GetRatedShow[*account.RatedTvResponse](url, mediaType, opts.language)
+		},
+		pages: func(fn func(*account.RatedTvResponse) error) error {
+			return account.RatedShowPages(url, mediaType, opts.language, fn)
+		},
+		results: func(resp *account.RatedTvResponse) *[]account.RatedTv { return &resp.Results },
+		print: func(out io.Writer, resp *account.RatedTvResponse, from int) error {
+			return printRatedTv(out, resp, genres, from, opts.locale)
+		},
+		columns: ratedColumns,
+	})
 }
 
-func printRatedMovies(out io.Writer, resp *account.RatedMoviesResponse) error {
+func printRatedMovies(out io.Writer, resp *account.RatedMoviesResponse, genres genre.Names, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Title: %s\n", r.Title)
-		fmt.Fprintf(w, "Release Date: %s\n", r.ReleaseDate)
-		fmt.Fprintf(w, "Popularity: %.2f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %.2f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
 	}
 	return w.Flush()
 }
 
-func printRatedTv(out io.Writer, resp *account.RatedTvResponse) error {
+func printRatedTv(out io.Writer, resp *account.RatedTvResponse, genres genre.Names, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Name: %s\n", r.Name)
-		fmt.Fprintf(w, "First Air Date: %s\n", r.FirstAirDate)
-		fmt.Fprintf(w, "Popularity: %.2f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %.2f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Name: %s\n", r.DisplayName())
+		loc.Fprintf(w, "First Air Date: %s\n", loc.Date(r.FirstAirDate))
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 2))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
 	}
 	return w.Flush()
 }
@@ -106,42 +130,306 @@ var getRatedEpisodesCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return getRatedEpisodesAction(os.Stdout, apiRoot, isRaw)
+		return getRatedEpisodesAction(stdout, apiRoot, opts)
 	},
 }
 
-func getRatedEpisodesAction(out io.Writer, apiRoot string, isRaw bool) error {
+func getRatedEpisodesAction(out io.Writer, apiRoot string, opts outputOptions) error {
 	url := fmt.Sprintf("%s/account/null", apiRoot)
 
-	resp, err := account.GetRatedEpisodes(url)
+	// Episodes have no genres to name or filter on
+	match := func([]int) bool { return true }
+
+	return showMediaList(out, apiRoot, opts, nil, match, mediaList[*account.RatedTvEpisodeResponse, account.RatedEpisode]{
+		get: func() (*account.RatedTvEpisodeResponse, error) {
+			return account.GetRatedEpisodes(url, opts.language)
+		},
+		pages: func(fn func(*account.RatedTvEpisodeResponse) error) error {
+			return account.RatedEpisodesPages(url, opts.language, fn)
+		},
+		results: func(resp *account.RatedTvEpisodeResponse) *[]account.RatedEpisode { return &resp.Results },
+		print: func(out io.Writer, resp *account.RatedTvEpisodeResponse, from int) error {
+			return printRatedTvEps(out, resp, from, opts.locale)
+		},
+		columns: episodeColumns,
+		fields:  episodeFieldNames,
+	})
+}
+
+func printRatedTvEps(out io.Writer, resp *account.RatedTvEpisodeResponse, from int, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	results := resp.Results
+	for i, r := range results {
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Name: %s\n", r.Name)
+		loc.Fprintf(w, "Eps Number: %d\n", r.EpisodeNumber)
+		loc.Fprintf(w, "Air Date: %s\n", loc.Date(r.AirDate))
+		loc.Fprintf(w, "Still: %s\n", r.StillPath)
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 2))
+	}
+	return w.Flush()
+}
+
+// ratedCacheAge is how long the ratings of the account are reused. Rating
+// changes made through this tool invalidate them right away
+const ratedCacheAge = 10 * time.Minute
+
+func ratedKey(mediaType string, id int) string {
+	return fmt.Sprintf("%s/%d", mediaType, id)
+}
+
+// ratedCacheKey is the key of the ratings of the account of AUTH_TOKEN,
+// the token being hashed so that it isn't written to the cache directory
+func ratedCacheKey(apiRoot string) string {
+	sum := sha256.Sum256([]byte(os.Getenv("AUTH_TOKEN")))
+	return cache.Key("rated", apiRoot, hex.EncodeToString(sum[:8]))
+}
+
+// getRatings returns the ratings given by the account to movies and TV
+// shows, keyed by ratedKey
+func getRatings(apiRoot string) (map[string]float64, error) {
+	key := ratedCacheKey(apiRoot)
+
+	if data, ok := cache.Get(key, ratedCacheAge); ok {
+		var ratings map[string]float64
+		if err := json.Unmarshal(data, &ratings); err == nil {
+			return ratings, nil
+		}
+	}
+
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	ratings := make(map[string]float64)
+
+	movies, err := account.GetRatedShowContext[*account.RatedMoviesResponse](lookupCtx, url, "movies", defaultLanguage)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range movies.Results {
+		ratings[ratedKey("movie", r.ID)] = r.Rating
+	}
+
+	shows, err := account.GetRatedShowContext[*account.RatedTvResponse](lookupCtx, url, "tv", defaultLanguage)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range shows.Results {
+		ratings[ratedKey("tv", r.ID)] = r.Rating
+	}
+
+	if data, err := json.Marshal(ratings); err == nil {
+		// A broken cache only costs another request, don't fail on it
+		_ = cache.Put(key, data)
+	}
+
+	return ratings, nil
+}
+
+var addRatingCmd = &cobra.Command{
+	Use:          "add <media_type> <media_id> <value>",
+	Short:        "Rate a movie or TV show\n\n<media_type>: movie or tv\n<media_id>: TMDB id, movie/550, IMDb/TVDB id or URL\n<value>: 0.5 to 10.0 in 0.5 steps\n",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return addRatingAction(stdout, apiRoot, args, opts)
+	},
+}
+
+var addEpisodeRatingCmd = &cobra.Command{
+	Use:          "add-ep <show_id> <season_number> <episode_number> <value>",
+	Short:        "Rate a TV episode\n\n<value>: 0.5 to 10.0 in 0.5 steps\n",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return addEpisodeRatingAction(stdout, apiRoot, args, opts)
+	},
+}
+
+var deleteRatingCmd = &cobra.Command{
+	Use:          "delete <media_type> <media_id>",
+	Short:        "Delete the rating of a movie or TV show\n\n<media_type>: movie or tv\n<media_id>: TMDB id, movie/550, IMDb/TVDB id or URL\n",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return deleteRatingAction(stdout, apiRoot, args, opts)
+	},
+}
+
+var deleteEpisodeRatingCmd = &cobra.Command{
+	Use:          "delete-ep <show_id> <season_number> <episode_number>",
+	Short:        "Delete the rating of a TV episode",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return deleteEpisodeRatingAction(stdout, apiRoot, args, opts)
+	},
+}
+
+// mediaURL builds the URL of a movie or TV show out of its <media_type>
+// and <media_id> arguments, the external ids being looked up in language
+func mediaURL(apiRoot string, args []string, language string) (string, error) {
+	mediaType, mediaID, err := resolveMedia(apiRoot, args, language)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%d", apiRoot, mediaType, mediaID), nil
+}
+
+// resolveMedia returns the media type and TMDB id of a movie or TV show
+// out of its <media_type> and <media_id> arguments
+func resolveMedia(apiRoot string, args []string, language string) (string, int, error) {
+	if args[0] != "movie" && args[0] != "tv" {
+		return "", 0, errors.New("invalid <media_type> value")
+	}
+
+	return resolveID(apiRoot, args[0], args[1], language)
+}
+
+// episodeURL builds the URL of a TV episode out of its <show_id>,
+// <season_number> and <episode_number> arguments, the external show ids
+// being looked up in language
+func episodeURL(apiRoot string, args []string, language string) (string, error) {
+	var (
+		nums [3]int
+		err  error
+	)
+
+	if _, nums[0], err = resolveID(apiRoot, "tv", args[0], language); err != nil {
+		return "", err
+	}
+	for i := 1; i < len(nums); i++ {
+		if nums[i], err = strconv.Atoi(args[i]); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%s/tv/%d/season/%d/episode/%d", apiRoot, nums[0], nums[1], nums[2]), nil
+}
+
+func parseRating(arg string) (float64, error) {
+	value, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return value, account.ValidateRating(value)
+}
+
+// forgetRatings drops the cached ratings after a change. The change being
+// saved already, a broken cache doesn't fail the command
+func forgetRatings(apiRoot string) {
+	_ = cache.Remove(ratedCacheKey(apiRoot))
+}
+
+func addRatingAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	// The value is checked before the id lookups, which may take a request
+	value, err := parseRating(args[2])
+	if err != nil {
+		return err
+	}
+
+	url, err := mediaURL(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	resp, err := account.AddRating(url, value)
+	if err != nil {
+		return err
+	}
+
+	forgetRatings(apiRoot)
+
+	return render(out, opts, resp)
+}
+
+func addEpisodeRatingAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	// The value is checked before the id lookups, which may take a request
+	value, err := parseRating(args[3])
+	if err != nil {
+		return err
+	}
+
+	url, err := episodeURL(apiRoot, args, opts.language)
 	if err != nil {
 		return err
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	resp, err := account.AddRating(url, value)
+	if err != nil {
+		return err
 	}
 
-	return printRatedTvEps(out, resp)
+	forgetRatings(apiRoot)
+
+	return render(out, opts, resp)
 }
 
-func printRatedTvEps(out io.Writer, resp *account.RatedTvEpisodeResponse) error {
-	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
-	results := resp.Results
-	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Name: %s\n", r.Name)
-		fmt.Fprintf(w, "Eps Number: %d\n", r.EpisodeNumber)
-		fmt.Fprintf(w, "Air Date: %s\n", r.AirDate)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %.2f\n\n", r.VoteAverage)
+func deleteRatingAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	url, err := mediaURL(apiRoot, args, opts.language)
+	if err != nil {
+		return err
 	}
-	return w.Flush()
+
+	resp, err := account.DeleteRating(url)
+	if err != nil {
+		return err
+	}
+
+	forgetRatings(apiRoot)
+
+	return render(out, opts, resp)
+}
+
+func deleteEpisodeRatingAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	url, err := episodeURL(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	resp, err := account.DeleteRating(url)
+	if err != nil {
+		return err
+	}
+
+	forgetRatings(apiRoot)
+
+	return render(out, opts, resp)
 }
 
 func init() {
@@ -149,14 +437,23 @@ func init() {
 
 	ratedCmd.AddCommand(getRatedCmd)
 	ratedCmd.AddCommand(getRatedEpisodesCmd)
+	ratedCmd.AddCommand(addRatingCmd)
+	ratedCmd.AddCommand(addEpisodeRatingCmd)
+	ratedCmd.AddCommand(deleteRatingCmd)
+	ratedCmd.AddCommand(deleteEpisodeRatingCmd)
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
 	// and all subcommands, e.g.:
 	// ratedCmd.PersistentFlags().String("foo", "", "A help for foo")
 
-	getRatedCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
-	getRatedEpisodesCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
+	getRatedCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	getRatedCmd.Flags().StringSlice("genre", nil, "Only show results having all these genres")
+	getRatedCmd.Flags().StringSlice("columns", nil, columnsUsage)
+	getRatedCmd.Flags().String("sort-by", "", "Sort on a --columns field, field:desc for descending order")
+	getRatedEpisodesCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	getRatedEpisodesCmd.Flags().StringSlice("columns", nil, episodeColumnsUsage)
+	getRatedEpisodesCmd.Flags().String("sort-by", "", "Sort on a --columns field, field:desc for descending order")
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
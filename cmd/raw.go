@@ -0,0 +1,128 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+// lookupCtx is the context of the requests made along the way by the
+// commands, e.g. to name the genres or find the account region. Their
+// responses are left out of --raw and --query-source server
+var lookupCtx = client.Lookup(context.Background())
+
+// Usages of --raw, for the commands filtering, sorting or combining several
+// responses, e.g. the pages of a list, and for the ones printing a single
+// response as is
+const (
+	rawUsage       = "Print the TMDB responses byte for byte as received, before any filtering or sorting, one document per response"
+	rawSingleUsage = "Print the TMDB response byte for byte as received"
+)
+
+// rawIndent is the indentation of the --pretty responses, the one of the
+// json output
+const rawIndent = "   "
+
+// renderRaw prints the TMDB responses received while running the command
+// as the server sent them, one document per response, e.g. per page, or
+// merged in a single array with --merge-pages. With --include-headers each
+// document follows the headers of its response, the merged one follows all
+// of them
+func renderRaw(out io.Writer, opts outputOptions) error {
+	resps := client.Recorded()
+	if len(resps) == 0 {
+		return errors.New("no response received from TMDB")
+	}
+
+	if opts.mergePages && len(resps) > 1 {
+		var merged bytes.Buffer
+		merged.WriteByte('[')
+		for i, r := range resps {
+			if opts.includeHeaders {
+				if err := printHeaders(out, r); err != nil {
+					return err
+				}
+			}
+			if i > 0 {
+				merged.WriteByte(',')
+			}
+			merged.Write(bytes.TrimSpace(r.Body))
+		}
+		merged.WriteByte(']')
+
+		return printDocument(out, opts, resps[0].URL, merged.Bytes(), true)
+	}
+
+	for i, r := range resps {
+		if opts.includeHeaders {
+			if err := printHeaders(out, r); err != nil {
+				return err
+			}
+		}
+		if err := printDocument(out, opts, r.URL, r.Body, i == len(resps)-1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printDocument prints the body of a response received from url, indented
+// with --pretty. The documents are separated by a newline, the last one
+// being left byte for byte as received
+func printDocument(out io.Writer, opts outputOptions, url string, d []byte, last bool) error {
+	if opts.pretty {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, d, "", rawIndent); err != nil {
+			return fmt.Errorf("%s: %w", url, err)
+		}
+		d = buf.Bytes()
+	}
+
+	if !last && !bytes.HasSuffix(d, []byte("\n")) {
+		d = append(d, '\n')
+	}
+	_, err := out.Write(d)
+	return err
+}
+
+// printHeaders prints the status line and the headers of r, followed by a
+// blank line
+func printHeaders(out io.Writer, r client.Response) error {
+	proto := r.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %d %s\n", proto, r.Status, http.StatusText(r.Status))
+
+	keys := make([]string, 0, len(r.Header))
+	for k := range r.Header {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		for _, v := range r.Header[k] {
+			fmt.Fprintf(&b, "%s: %s\n", k, v)
+		}
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
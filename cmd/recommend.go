@@ -0,0 +1,257 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// maxReasons is the number of liked movies shown to explain a recommendation
+const maxReasons = 3
+
+// recommendOptions controls which movies seed the recommendations and how
+// many are printed
+type recommendOptions struct {
+	minRating float64
+	limit     int
+}
+
+// recommendSeed is a liked movie, its weight scales the score of the movies
+// it brings in
+type recommendSeed struct {
+	id     int
+	title  string
+	weight float64
+}
+
+// recommendation is a movie scored by the liked movies recommending it
+type recommendation struct {
+	ID          int      `json:"id"`
+	Title       string   `json:"title"`
+	ReleaseDate string   `json:"release_date"`
+	VoteAverage float64  `json:"vote_average"`
+	Score       float64  `json:"score"`
+	Because     []string `json:"because"`
+
+	// contributions holds the score brought by each liked movie, by id as
+	// two of them may share a title
+	contributions map[int]float64
+}
+
+// recommendCmd represents the recommend command
+var recommendCmd = &cobra.Command{
+	Use:   "recommend",
+	Short: "Recommend movies based on your favorites and ratings",
+	Long: `Recommend movies based on your favorites and ratings.
+
+The recommended and similar movies of every favorite movie and of every movie
+rated at least --min-rating are scored by how often they come up, weighted by
+your ratings. Movies already in your favorites, watchlist or ratings are left
+out.`,
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		var ropts recommendOptions
+
+		if ropts.minRating, err = cmd.Flags().GetFloat64("min-rating"); err != nil {
+			return err
+		}
+		if ropts.limit, err = cmd.Flags().GetInt("limit"); err != nil {
+			return err
+		}
+
+		return recommendAction(stdout, apiRoot, ropts, opts)
+	},
+}
+
+func recommendAction(out io.Writer, apiRoot string, ropts recommendOptions, opts outputOptions) error {
+	url := fmt.Sprintf("%s/account/null", apiRoot)
+
+	favorites, err := account.GetFavorite[*account.FavoriteMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	rated, err := account.GetRatedShow[*account.RatedMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	watchlist, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, "movies", opts.language)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[int]bool)
+	for _, r := range watchlist.Results {
+		known[r.ID] = true
+	}
+
+	// A favorite counts as a perfect rating unless it was rated
+	var seeds []recommendSeed
+	ratings := make(map[int]float64)
+	for _, r := range rated.Results {
+		known[r.ID] = true
+		ratings[r.ID] = r.Rating
+		if r.Rating >= ropts.minRating {
+			seeds = append(seeds, recommendSeed{r.ID, r.DisplayTitle(), r.Rating / 10})
+		}
+	}
+	for _, r := range favorites.Results {
+		known[r.ID] = true
+		if _, ok := ratings[r.ID]; !ok {
+			seeds = append(seeds, recommendSeed{r.ID, r.DisplayTitle(), 1})
+		}
+	}
+
+	recs, err := aggregateRecommendations(apiRoot, opts.language, seeds, known)
+	if err != nil {
+		return err
+	}
+
+	if ropts.limit > 0 && len(recs) > ropts.limit {
+		recs = recs[:ropts.limit]
+	}
+
+	if !opts.human() {
+		return render(out, opts, recs)
+	}
+
+	return printRecommendations(out, recs, opts.locale)
+}
+
+// aggregateRecommendations scores the recommended and similar movies of
+// seeds, leaving out the known ones, and ranks them by score
+func aggregateRecommendations(apiRoot, language string, seeds []recommendSeed, known map[int]bool) ([]*recommendation, error) {
+	// The recommended and similar movies of the seeds are fetched
+	// concurrently, then aggregated in the order of the seeds so that the
+	// scores add up the same on every run
+	gets := []func(string, string, int) (*movie.ListResponse, error){
+		movie.GetRecommendations,
+		movie.GetSimilar,
+	}
+	found := make([]*movie.ListResponse, len(seeds)*len(gets))
+	err := eachParallel(len(found), func(i int) error {
+		s, get := seeds[i/len(gets)], gets[i%len(gets)]
+		resp, err := get(fmt.Sprintf("%s/movie/%d", apiRoot, s.id), language, 1)
+		found[i] = resp
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*recommendation)
+	for i, resp := range found {
+		s := seeds[i/len(gets)]
+
+		for _, r := range resp.Results {
+			if known[r.ID] {
+				continue
+			}
+
+			rec, ok := byID[r.ID]
+			if !ok {
+				rec = &recommendation{
+					ID:            r.ID,
+					Title:         r.Title,
+					ReleaseDate:   r.ReleaseDate,
+					VoteAverage:   r.VoteAverage,
+					contributions: make(map[int]float64),
+				}
+				byID[r.ID] = rec
+			}
+
+			rec.Score += s.weight
+			rec.contributions[s.id] += s.weight
+		}
+	}
+
+	titles := make(map[int]string, len(seeds))
+	for _, s := range seeds {
+		titles[s.id] = s.title
+	}
+
+	recs := make([]*recommendation, 0, len(byID))
+	for _, rec := range byID {
+		ids := make([]int, 0, len(rec.contributions))
+		for id := range rec.contributions {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			ci, cj := rec.contributions[ids[i]], rec.contributions[ids[j]]
+			if ci != cj {
+				return ci > cj
+			}
+			if titles[ids[i]] != titles[ids[j]] {
+				return titles[ids[i]] < titles[ids[j]]
+			}
+			return ids[i] < ids[j]
+		})
+
+		rec.Because = make([]string, len(ids))
+		for i, id := range ids {
+			rec.Because[i] = titles[id]
+		}
+		recs = append(recs, rec)
+	}
+
+	sort.Slice(recs, func(i, j int) bool {
+		if recs[i].Score != recs[j].Score {
+			return recs[i].Score > recs[j].Score
+		}
+		if recs[i].VoteAverage != recs[j].VoteAverage {
+			return recs[i].VoteAverage > recs[j].VoteAverage
+		}
+		return recs[i].ID < recs[j].ID
+	})
+
+	return recs, nil
+}
+
+func printRecommendations(out io.Writer, recs []*recommendation, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	fmt.Fprint(w, loc.T("Recommended Movies:\n"))
+	for i, r := range recs {
+		because := r.Because
+		if len(because) > maxReasons {
+			because = because[:maxReasons]
+		}
+
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Title: %s\n", r.Title)
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		loc.Fprintf(w, "Vote Average: %s\n", loc.Float(r.VoteAverage, 2))
+		loc.Fprintf(w, "Score: %s\n", loc.Float(r.Score, 2))
+		loc.Fprintf(w, "Because you liked %s\n\n", strings.Join(because, ", "))
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(recommendCmd)
+
+	recommendCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	recommendCmd.Flags().Float64("min-rating", 7, "Minimum rating of the rated movies used as a basis")
+	recommendCmd.Flags().IntP("limit", "n", 20, "Maximum number of recommendations, 0 for all")
+}
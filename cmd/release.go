@@ -0,0 +1,331 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/certification"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/movie"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// releaseDatesCmd represents the release-dates command
+var releaseDatesCmd = &cobra.Command{
+	Use:   "release-dates <movie_id>",
+	Short: "Get the release dates and certifications of a movie",
+	Long: `Get the release dates and certifications of a movie.
+
+All the regions are listed unless --region is given.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+		region := viper.GetString("region")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return releaseDatesAction(stdout, apiRoot, args, region, opts)
+	},
+}
+
+func releaseDatesAction(out io.Writer, apiRoot string, args []string, region string, opts outputOptions) error {
+	_, movieID, err := resolveID(apiRoot, "movie", args[0], opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/movie/%d", apiRoot, movieID)
+
+	resp, err := movie.GetReleaseDates(url)
+	if err != nil {
+		return err
+	}
+
+	if region != "" {
+		resp.Filter(region)
+	}
+
+	sort.Slice(resp.Results, func(i, j int) bool {
+		return resp.Results[i].ISO_3166_1 < resp.Results[j].ISO_3166_1
+	})
+	for _, r := range resp.Results {
+		sort.SliceStable(r.ReleaseDates, func(i, j int) bool {
+			return r.ReleaseDates[i].ReleaseDate < r.ReleaseDates[j].ReleaseDate
+		})
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printReleaseDates(out, resp, opts.locale)
+}
+
+func printReleaseDates(out io.Writer, resp *movie.ReleaseDatesResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	loc.Fprintf(w, "Release dates for movie %d\n", resp.ID)
+	fmt.Fprint(w, loc.T("Region\tDate\tType\tCertification\tNote\n"))
+	for _, r := range resp.Results {
+		for _, rd := range r.ReleaseDates {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.ISO_3166_1, loc.Date(rd.Date()), loc.T(rd.TypeName()), dash(rd.Certification), rd.Note)
+		}
+	}
+	return w.Flush()
+}
+
+// certificationsCmd represents the certifications command
+var certificationsCmd = &cobra.Command{
+	Use:          "certifications <media_type>",
+	Short:        "Get the official certifications of your region\n<media_type>: movie or tv",
+	SilenceUsage: true,
+	Args:         cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:    []string{"movie", "tv"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+		region := viper.GetString("region")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return certificationsAction(stdout, apiRoot, args, region, opts)
+	},
+}
+
+func certificationsAction(out io.Writer, apiRoot string, args []string, region string, opts outputOptions) error {
+	region, err := resolveRegion(apiRoot, region)
+	if err != nil {
+		return err
+	}
+
+	resp, err := certification.Load(context.Background(), apiRoot, args[0])
+	if err != nil {
+		return err
+	}
+
+	certs := resp.Region(region)
+
+	if !opts.human() {
+		return render(out, opts, certs)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	opts.locale.Fprintf(w, "Certifications for %s in %s\n", args[0], region)
+	fmt.Fprint(w, opts.locale.T("Certification\tMeaning\n"))
+	for _, c := range certs {
+		fmt.Fprintf(w, "%s\t%s\n", c.Certification, c.Meaning)
+	}
+	return w.Flush()
+}
+
+// dash stands in for missing values
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// releaseOptions filters and sorts movies on their release in a region
+// rather than on their primary release date
+type releaseOptions struct {
+	region           string
+	releaseType      string
+	after            string
+	before           string
+	maxCertification string
+	sortBy           string
+}
+
+func getReleaseOptions(cmd *cobra.Command) (releaseOptions, error) {
+	var (
+		ropts releaseOptions
+		err   error
+	)
+
+	ropts.region = viper.GetString("region")
+	if ropts.releaseType, err = cmd.Flags().GetString("release-type"); err != nil {
+		return ropts, err
+	}
+	if ropts.after, err = cmd.Flags().GetString("released-after"); err != nil {
+		return ropts, err
+	}
+	if ropts.before, err = cmd.Flags().GetString("released-before"); err != nil {
+		return ropts, err
+	}
+	if ropts.maxCertification, err = cmd.Flags().GetString("max-certification"); err != nil {
+		return ropts, err
+	}
+
+	// The other --sort-by fields are the ones of the list commands
+	sortBy, err := cmd.Flags().GetString("sort-by")
+	if err != nil {
+		return ropts, err
+	}
+	if releaseSort(sortBy) {
+		_, order, _ := strings.Cut(sortBy, ":")
+		if order != "" && order != "asc" && order != "desc" {
+			return ropts, fmt.Errorf("invalid --sort-by order %q, use asc or desc", order)
+		}
+		ropts.sortBy = sortBy
+	}
+
+	return ropts, nil
+}
+
+// releaseSort reports whether sortBy sorts on the regional release date,
+// i.e. is release-date[:asc|desc]
+func releaseSort(sortBy string) bool {
+	field, _, _ := strings.Cut(sortBy, ":")
+	return field == "release-date"
+}
+
+// enabled reports whether the regional releases have to be looked up
+func (o releaseOptions) enabled() bool {
+	return o.releaseType != "" || o.after != "" || o.before != "" ||
+		o.maxCertification != "" || o.sortBy != ""
+}
+
+// localRelease is the release of a movie in a region
+type localRelease struct {
+	Date          string `json:"date"`
+	Certification string `json:"certification"`
+}
+
+// localReleases holds the regional releases of a set of movies
+type localReleases struct {
+	region      string
+	releaseType string
+	byID        map[int]localRelease
+}
+
+// getLocalReleases looks up the regional release of the movies ids and
+// returns a function reporting whether a movie passes the ropts filters
+func getLocalReleases(apiRoot string, ids []int, ropts releaseOptions) (*localReleases, func(id int) bool, error) {
+	if ropts.releaseType == "" {
+		ropts.releaseType = "theatrical"
+	}
+
+	types, err := movie.ReleaseTypes(ropts.releaseType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, d := range []string{ropts.after, ropts.before} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	region, err := resolveRegion(apiRoot, ropts.region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxOrder := -1
+	var certs *certification.ListResponse
+	if ropts.maxCertification != "" {
+		if certs, err = certification.Load(lookupCtx, apiRoot, "movie"); err != nil {
+			return nil, nil, err
+		}
+		if maxOrder, err = certs.Order(region, ropts.maxCertification); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	local := &localReleases{
+		region:      region,
+		releaseType: ropts.releaseType,
+		byID:        make(map[int]localRelease, len(ids)),
+	}
+
+	releases := make([]localRelease, len(ids))
+	err = eachParallel(len(ids), func(i int) error {
+		resp, err := movie.GetReleaseDatesContext(lookupCtx, fmt.Sprintf("%s/movie/%d", apiRoot, ids[i]))
+		if err != nil {
+			return err
+		}
+		releases[i] = localRelease{
+			Date:          resp.Date(region, types),
+			Certification: resp.Certification(region),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, id := range ids {
+		local.byID[id] = releases[i]
+	}
+
+	match := func(id int) bool {
+		l := local.byID[id]
+
+		if ropts.after != "" && (l.Date == "" || l.Date < ropts.after) {
+			return false
+		}
+		if ropts.before != "" && (l.Date == "" || l.Date > ropts.before) {
+			return false
+		}
+
+		// Movies without a known certification can't be vouched for
+		if maxOrder >= 0 {
+			order, err := certs.Order(region, l.Certification)
+			if err != nil || order > maxOrder {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return local, match, nil
+}
+
+// less orders the movies by regional release date, the latest first when
+// desc is set, undated ones last in both orders
+func (l *localReleases) less(a, b int, desc bool) bool {
+	da, db := l.byID[a].Date, l.byID[b].Date
+	if da == "" || db == "" {
+		return da != "" && db == ""
+	}
+	if desc {
+		return da > db
+	}
+	return da < db
+}
+
+// label names the regional release date in loc, e.g. Theatrical Release (US)
+func (l *localReleases) label(loc *i18n.Locale) string {
+	if l.releaseType == "digital" {
+		return loc.Sprintf("Digital Release (%s)", l.region)
+	}
+	return loc.Sprintf("Theatrical Release (%s)", l.region)
+}
+
+func init() {
+	rootCmd.AddCommand(releaseDatesCmd)
+	rootCmd.AddCommand(certificationsCmd)
+
+	releaseDatesCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	certificationsCmd.Flags().BoolP("raw", "r", false, rawUsage)
+}
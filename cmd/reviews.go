@@ -0,0 +1,145 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/output"
+	"example.com/dummyheaad/tmdbCLI/review"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var errInvalidPage = errors.New("invalid [page] value")
+
+// reviewLines is the number of lines of content shown without --full
+const reviewLines = 6
+
+// reviewIndent is the indentation of the review content
+const reviewIndent = "   "
+
+// reviewsOptions controls how the review content is rendered
+type reviewsOptions struct {
+	full  bool
+	width int
+}
+
+// reviewsCmd represents the reviews command
+var reviewsCmd = &cobra.Command{
+	Use:   "reviews <media_type> <media_id> [page]",
+	Short: "Read the community reviews of a movie or TV show\n<media_type>: movie or tv",
+	Long: `Read the community reviews of a movie or TV show.
+
+The content is wrapped to the terminal width and cut after a few lines, use
+--full to read the whole reviews.`,
+	SilenceUsage: true,
+	Args:         cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		ropts := reviewsOptions{width: terminalWidth(os.Stdout)}
+		if ropts.full, err = cmd.Flags().GetBool("full"); err != nil {
+			return err
+		}
+
+		return reviewsAction(stdout, apiRoot, args, ropts, opts)
+	},
+}
+
+func reviewsAction(out io.Writer, apiRoot string, args []string, ropts reviewsOptions, opts outputOptions) error {
+	page := 1
+	if len(args) > 2 {
+		var err error
+		page, err = strconv.Atoi(args[2])
+		if err != nil {
+			return err
+		}
+		if page < 1 {
+			return errInvalidPage
+		}
+	}
+
+	mediaType, mediaID, err := resolveMedia(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/%s/%d", apiRoot, mediaType, mediaID)
+
+	resp, err := review.Get(url, page, opts.language)
+	if err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return printReviews(out, mediaType, resp, ropts, opts.locale)
+}
+
+func printReviews(out io.Writer, mediaType string, resp *review.Response, ropts reviewsOptions, loc *i18n.Locale) error {
+	width := ropts.width - len(reviewIndent)
+	if width < 20 {
+		width = 20
+	}
+
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Reviews for %s %d (page %d of %d, %d reviews)\n",
+		mediaType, resp.ID, resp.Page, resp.TotalPages, resp.TotalResults)
+	for i, r := range resp.Results {
+		rating := "-"
+		if r.AuthorDetails.Rating != nil {
+			rating = loc.Float(*r.AuthorDetails.Rating, 1)
+		}
+
+		created, _, _ := strings.Cut(r.CreatedAt, "T")
+
+		fmt.Fprintf(w, "%d. ", i+1)
+		loc.Fprintf(w, "Author: %s\n", r.Author)
+		loc.Fprintf(w, "Rating: %s\n", rating)
+		loc.Fprintf(w, "Created: %s\n", loc.Date(created))
+		loc.Fprintf(w, "URL: %s\n", r.URL)
+
+		lines := output.Wrap(strings.TrimSpace(r.Content), width)
+		truncated := !ropts.full && len(lines) > reviewLines
+		if truncated {
+			lines = lines[:reviewLines]
+		}
+		for _, l := range lines {
+			if l == "" {
+				fmt.Fprint(w, "\n")
+				continue
+			}
+			fmt.Fprintf(w, "%s%s\n", reviewIndent, l)
+		}
+		if truncated {
+			loc.Fprintf(w, "%s[...] use --full to read the whole review\n", reviewIndent)
+		}
+		fmt.Fprint(w, "\n")
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(reviewsCmd)
+
+	reviewsCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	reviewsCmd.Flags().Bool("full", false, "Print the whole content of the reviews")
+}
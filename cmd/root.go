@@ -6,25 +6,38 @@ Check LICENSE for details.
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"example.com/dummyheaad/tmdbCLI/output"
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// defaultLanguage is used for the data that doesn't follow --language, e.g.
+// the account ratings shown along the person credits
+const defaultLanguage = "en-US"
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "tmdbCLI",
 	Short: "A client app (CLI based) for TMDB REST API",
 	Long: `tmdbCLI is a CLI based client app, build using Golang that can be used
 to perform request into The Movie Database (TMDB) REST API.`,
+	SilenceErrors: true,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		err := godotenv.Load()
 		if err != nil {
 			return err
 		}
+
+		if pageOutput(cmd, isTerminal(os.Stdout)) {
+			startPager()
+		}
 		return nil
 	},
 	// Uncomment the following line if your bare application
@@ -36,7 +49,13 @@ to perform request into The Movie Database (TMDB) REST API.`,
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	err := rootCmd.Execute()
-	if err != nil {
+	if errors.Is(err, errPagerQuit) {
+		err = nil
+	}
+
+	// The error is printed once the pager is left, so that it shows
+	if err := errors.Join(err, stopPager()); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
 		os.Exit(1)
 	}
 }
@@ -50,12 +69,63 @@ func init() {
 
 	rootCmd.PersistentFlags().String("api-root",
 		"https://api.themoviedb.org/3", "TMDB API URL")
+	rootCmd.PersistentFlags().String("language", defaultLanguage,
+		"Language used for genres and other localized data")
+	rootCmd.PersistentFlags().String("image-size", "original",
+		"Size of the images URLs, e.g. w500 or original")
+	rootCmd.PersistentFlags().String("region", "",
+		"ISO 3166-1 country code used for regional data (default: your account country)")
+	rootCmd.PersistentFlags().StringP("output", "o", output.Text,
+		"Output format: "+strings.Join(output.Formats(), ", "))
+	rootCmd.PersistentFlags().String("template", "",
+		"Go template rendering the results, or @file to read it from, overrides --output. "+
+			"Helpers: date, truncate, join, stars and image, e.g. {{date \"Jan 2, 2006\" .ReleaseDate}}, "+
+			"{{truncate 40 .Overview}} or {{image \"poster\" .PosterPath \"w185\"}}")
+	rootCmd.PersistentFlags().Bool("wrap", false,
+		"Wrap the table cells too wide for the terminal rather than truncating them")
+	rootCmd.PersistentFlags().StringP("query", "q", "",
+		"jq-like expression filtering the results, e.g. '.results[] | select(.vote_average > 7) | .title'")
+	rootCmd.PersistentFlags().String("query-source", "typed",
+		"What --query runs against: typed, the results as printed by --output json, or server, the TMDB responses as received")
+
+	rootCmd.PersistentFlags().String("ui-language", "",
+		"Language of the labels, the dates and the numbers printed, --language being the one of the TMDB data: "+
+			strings.Join(i18n.Languages(), ", ")+" (default: from $LC_ALL, $LC_MESSAGES or $LANG, e.g. fr_FR.UTF-8)")
+	rootCmd.PersistentFlags().String("color", "auto",
+		"Color the tables: auto, always or never, auto being off with $NO_COLOR or when not printing to a terminal. "+
+			"$TMDB_THEME changes the styles, e.g. heading=bold+blue,good=green,meta=none")
+	rootCmd.PersistentFlags().Bool("no-pager", false,
+		"Don't show the output longer than the terminal through $TMDB_PAGER, $PAGER or less -R, same as TMDB_NO_PAGER=true")
+	rootCmd.PersistentFlags().Bool("pretty", false,
+		"Indent the --raw responses")
+	rootCmd.PersistentFlags().Bool("include-headers", false,
+		"Print the status and headers of the --raw responses before them")
+	rootCmd.PersistentFlags().Bool("merge-pages", false,
+		"Print the --raw responses of several pages as a single json array")
 
 	replacer := strings.NewReplacer("-", "_")
 	viper.SetEnvKeyReplacer(replacer)
 	viper.SetEnvPrefix("TMDB")
 
 	viper.BindPFlag("api-root", rootCmd.PersistentFlags().Lookup("api-root"))
+	viper.BindPFlag("language", rootCmd.PersistentFlags().Lookup("language"))
+	viper.BindPFlag("image-size", rootCmd.PersistentFlags().Lookup("image-size"))
+	viper.BindPFlag("region", rootCmd.PersistentFlags().Lookup("region"))
+	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
+	viper.BindPFlag("template", rootCmd.PersistentFlags().Lookup("template"))
+	viper.BindPFlag("wrap", rootCmd.PersistentFlags().Lookup("wrap"))
+	viper.BindPFlag("query", rootCmd.PersistentFlags().Lookup("query"))
+	viper.BindPFlag("query-source", rootCmd.PersistentFlags().Lookup("query-source"))
+	viper.BindPFlag("ui-language", rootCmd.PersistentFlags().Lookup("ui-language"))
+	viper.BindPFlag("color", rootCmd.PersistentFlags().Lookup("color"))
+	viper.BindPFlag("no-pager", rootCmd.PersistentFlags().Lookup("no-pager"))
+	viper.BindEnv("no-pager")
+	viper.BindEnv("pager")
+	viper.BindPFlag("pretty", rootCmd.PersistentFlags().Lookup("pretty"))
+	viper.BindPFlag("include-headers", rootCmd.PersistentFlags().Lookup("include-headers"))
+	viper.BindPFlag("merge-pages", rootCmd.PersistentFlags().Lookup("merge-pages"))
+	viper.BindEnv("theme")
+	viper.BindEnv("service")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
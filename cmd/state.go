@@ -0,0 +1,149 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/i18n"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var stateCmd = &cobra.Command{
+	Use:   "state <media_type> <media_id>...",
+	Short: "Check whether movies/tv shows are favorites, on the watchlist or rated",
+	Long: `Check whether movies/tv shows are favorites, on the watchlist or rated.
+
+<media_type>: movie or tv
+<media_id>: TMDB id, movie/550, IMDb/TVDB id or URL, several ids print a matrix`,
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return stateAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func stateAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	urls := make([]string, 0, len(args)-1)
+	for _, id := range args[1:] {
+		url, err := mediaURL(apiRoot, []string{args[0], id}, opts.language)
+		if err != nil {
+			return err
+		}
+		urls = append(urls, url)
+	}
+
+	states := make([]*account.StatesResponse, 0, len(urls))
+	for _, url := range urls {
+		resp, err := account.GetStates(url)
+		if err != nil {
+			return err
+		}
+		states = append(states, resp)
+	}
+
+	if !opts.human() {
+		if len(states) == 1 {
+			return render(out, opts, states[0])
+		}
+		return render(out, opts, states)
+	}
+
+	if len(states) == 1 {
+		return printState(out, args[0], states[0], opts.locale)
+	}
+
+	return printStates(out, states, opts.locale)
+}
+
+var stateEpisodeCmd = &cobra.Command{
+	Use:          "state-ep <show_id> <season_number> <episode_number>",
+	Short:        "Check whether a TV episode is rated",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return stateEpisodeAction(stdout, apiRoot, args, opts)
+	},
+}
+
+func stateEpisodeAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	url, err := episodeURL(apiRoot, args, opts.language)
+	if err != nil {
+		return err
+	}
+
+	resp, err := account.GetStates(url)
+	if err != nil {
+		return err
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	opts.locale.Fprintf(w, "Account state for episode %d\n", resp.ID)
+	opts.locale.Fprintf(w, "Rating: %s\n", formatRating(resp.Rated, opts.locale))
+	return w.Flush()
+}
+
+func yesNo(b bool, loc *i18n.Locale) string {
+	if b {
+		return loc.T("yes")
+	}
+	return loc.T("no")
+}
+
+func formatRating(r account.StateRating, loc *i18n.Locale) string {
+	if !r.Rated {
+		return "-"
+	}
+	return loc.Float(r.Value, 1)
+}
+
+func printState(out io.Writer, mediaType string, resp *account.StatesResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
+	loc.Fprintf(w, "Account state for %s %d\n", mediaType, resp.ID)
+	loc.Fprintf(w, "Favorite: %s\n", yesNo(resp.Favorite, loc))
+	loc.Fprintf(w, "Watchlist: %s\n", yesNo(resp.Watchlist, loc))
+	loc.Fprintf(w, "Rating: %s\n", formatRating(resp.Rated, loc))
+	return w.Flush()
+}
+
+func printStates(out io.Writer, states []*account.StatesResponse, loc *i18n.Locale) error {
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	fmt.Fprint(w, loc.T("ID\tFavorite\tWatchlist\tRating\n"))
+	for _, s := range states {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.ID, yesNo(s.Favorite, loc), yesNo(s.Watchlist, loc), formatRating(s.Rated, loc))
+	}
+	return w.Flush()
+}
+
+func init() {
+	accountCmd.AddCommand(stateCmd)
+	accountCmd.AddCommand(stateEpisodeCmd)
+
+	stateCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	stateEpisodeCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
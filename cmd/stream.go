@@ -0,0 +1,152 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"io"
+
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/output"
+)
+
+// mediaList tells how a list command gets and prints its results, the
+// responses R holding a page of results T
+type mediaList[R imageResolver, T mediaResult] struct {
+	// get fetches all the pages merged, pages passes them to fn one after
+	// the other as they are received
+	get   func() (R, error)
+	pages func(fn func(resp R) error) error
+
+	// results points to the results of resp
+	results func(resp R) *[]T
+
+	// print prints resp in the text layout, numbering the results from
+	// from+1, the heading going along with the first page
+	print func(out io.Writer, resp R, from int) error
+
+	// columns are the columns printed by default to a terminal, fields
+	// the ones printed as csv or tsv, mediaFieldNames when left out
+	columns []string
+	fields  []string
+}
+
+// columnsOf returns the columns of l printed with opts, see selectColumns
+func (l mediaList[R, T]) columnsOf(opts outputOptions) []string {
+	fields := l.fields
+	if fields == nil {
+		fields = mediaFieldNames
+	}
+	return selectColumns(opts, l.columns, fields)
+}
+
+// streamable tells whether the results of the list commands are printed
+// page after page as they are received: in the text list layout, in a
+// table fitting the first page or in a streaming format, unless they have
+// to be sorted first
+func (o outputOptions) streamable() bool {
+	if o.sortBy != "" || o.raw || o.query != nil || o.template != nil {
+		return false
+	}
+	return o.human() || output.Streaming(o.outputFormat())
+}
+
+// showMediaList prints the results of l matching the --genre filter. They
+// are streamed when the output allows it, so that the first pages show
+// while the next ones are fetched, and a failing output, e.g. a closed
+// pipe, stops the requests
+func showMediaList[R imageResolver, T mediaResult](out io.Writer, apiRoot string, opts outputOptions,
+	genres genre.Names, match func([]int) bool, l mediaList[R, T]) error {
+
+	prepare := func(resp R) error {
+		results := l.results(resp)
+
+		kept := (*results)[:0]
+		for _, r := range *results {
+			if match(r.Media().GenreIds) {
+				kept = append(kept, r)
+			}
+		}
+		*results = kept
+
+		return resolveImages(apiRoot, opts, resp)
+	}
+
+	if opts.streamable() {
+		return streamMediaList(out, opts, genres, l, prepare)
+	}
+
+	resp, err := l.get()
+	if err != nil {
+		return err
+	}
+
+	if err := prepare(resp); err != nil {
+		return err
+	}
+
+	results := *l.results(resp)
+	sortMedia(results, genres, opts.sortBy)
+
+	if opts.tabular() {
+		return printColumns(out, opts, results, genres, l.columnsOf(opts))
+	}
+
+	if !opts.human() {
+		return render(out, opts, resp)
+	}
+
+	return l.print(out, resp, 0)
+}
+
+func streamMediaList[R imageResolver, T mediaResult](out io.Writer, opts outputOptions,
+	genres genre.Names, l mediaList[R, T], prepare func(resp R) error) error {
+
+	var (
+		stream *output.Stream
+		table  *output.TableStream
+		err    error
+	)
+	switch {
+	case opts.human() && opts.tabular():
+		table = mediaTable(out, opts, l.columnsOf(opts))
+	case !opts.human():
+		if stream, err = output.NewStream(out, opts.outputFormat()); err != nil {
+			return err
+		}
+	}
+
+	printed := 0
+	err = l.pages(func(resp R) error {
+		if err := prepare(resp); err != nil {
+			return err
+		}
+
+		results := *l.results(resp)
+
+		switch {
+		case table != nil:
+			return table.Write(mediaRows(opts, results, genres, l.columnsOf(opts)))
+		case stream == nil:
+			err := l.print(out, resp, printed)
+			printed += len(results)
+			return err
+		case opts.tabular():
+			return stream.Write(mediaRecords(results, genres, l.columnsOf(opts)))
+		}
+		return stream.Write(resp)
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case table != nil:
+		return table.Close()
+	case stream != nil:
+		return stream.Close()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"io"
+	"os"
+	"strconv"
+)
+
+// defaultWidth and defaultHeight are used when the output isn't a terminal
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// terminalWidth returns the number of columns available on out. $COLUMNS
+// takes precedence over the size reported by the terminal
+func terminalWidth(out io.Writer) int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+
+	if f, ok := out.(*os.File); ok {
+		if n, _, ok := termSize(f); ok && n > 0 {
+			return n
+		}
+	}
+
+	return defaultWidth
+}
+
+// terminalHeight returns the number of lines of out. $LINES takes
+// precedence over the size reported by the terminal
+func terminalHeight(out io.Writer) int {
+	if n, err := strconv.Atoi(os.Getenv("LINES")); err == nil && n > 0 {
+		return n
+	}
+
+	if f, ok := out.(*os.File); ok {
+		if _, n, ok := termSize(f); ok && n > 0 {
+			return n
+		}
+	}
+
+	return defaultHeight
+}
+
+// isTerminal reports whether out is a terminal
+func isTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+
+	_, _, ok = termSize(f)
+	return ok
+}
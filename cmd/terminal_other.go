@@ -0,0 +1,10 @@
+//go:build !unix
+
+package cmd
+
+import "os"
+
+// termSize can't query the terminal on this platform
+func termSize(f *os.File) (int, int, bool) {
+	return 0, 0, false
+}
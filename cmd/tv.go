@@ -0,0 +1,82 @@
+/*
+Copyright © 2025 Aysuka Ansari, LLC
+Copyrights apply to this source code.
+Check LICENSE for details.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// tvCmd represents the tv command
+var tvCmd = &cobra.Command{
+	Use:          "tv",
+	Short:        "TMDB API for TV shows",
+	SilenceUsage: true,
+}
+
+var tvKeywordsCmd = &cobra.Command{
+	Use:          "keywords <series_id>",
+	Short:        "Get the keywords of a TV show",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return keywordsAction(stdout, apiRoot, "tv", args, opts)
+	},
+}
+
+var tvAltTitlesCmd = &cobra.Command{
+	Use:          "alt-titles <series_id>",
+	Short:        "Get the alternative titles of a TV show, only the ones of --region when given",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+		region := viper.GetString("region")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return altTitlesAction(stdout, apiRoot, "tv", args, region, opts)
+	},
+}
+
+var tvTranslationsCmd = &cobra.Command{
+	Use:          "translations <series_id>",
+	Short:        "Get the translations of a TV show",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiRoot := viper.GetString("api-root")
+
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return translationsAction(stdout, apiRoot, "tv", args, opts)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tvCmd)
+
+	tvCmd.AddCommand(tvKeywordsCmd)
+	tvCmd.AddCommand(tvAltTitlesCmd)
+	tvCmd.AddCommand(tvTranslationsCmd)
+
+	tvKeywordsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+	tvAltTitlesCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	tvTranslationsCmd.Flags().BoolP("raw", "r", false, rawSingleUsage)
+}
@@ -9,11 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"strconv"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/genre"
+	"example.com/dummyheaad/tmdbCLI/i18n"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,28 +28,41 @@ var watchlistCmd = &cobra.Command{
 }
 
 var addWatchlistCmd = &cobra.Command{
-	Use:          "add <media_type> <media_id> <is_watchlist>",
+	Use:          "add [media_type] <media_id> <is_watchlist>",
 	Short:        "Add a movie or TV show to your watchlist",
 	SilenceUsage: true,
-	Args:         cobra.ExactArgs(3),
+	Args:         cobra.RangeArgs(2, 3),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		return addWatchlistAction(os.Stdout, apiRoot, args)
+		opts, err := getOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return addWatchlistAction(stdout, apiRoot, args, opts)
 	},
 }
 
-func addWatchlistAction(out io.Writer, apiRoot string, args []string) error {
-	mediaType := args[0]
-	if mediaType != "movie" && mediaType != "tv" {
+func addWatchlistAction(out io.Writer, apiRoot string, args []string, opts outputOptions) error {
+	// <media_type> may be left out when the id tells it, e.g. a URL
+	if len(args) == 2 {
+		args = append([]string{""}, args...)
+	}
+
+	if args[0] != "" && args[0] != "movie" && args[0] != "tv" {
 		return errors.New("invalid <media_type> value")
 	}
 
-	mediaID, err := strconv.Atoi(args[1])
+	mediaType, mediaID, err := resolveID(apiRoot, args[0], args[1], opts.language)
 	if err != nil {
 		return err
 	}
 
+	if mediaType != "movie" && mediaType != "tv" {
+		return errors.New("invalid <media_type> value")
+	}
+
 	var isWatchlist bool
 	switch args[2] {
 	case "yes":
@@ -65,7 +80,7 @@ func addWatchlistAction(out io.Writer, apiRoot string, args []string) error {
 		return err
 	}
 
-	return printResp(out, resp)
+	return render(out, opts, resp)
 }
 
 var getWatchlistCmd = &cobra.Command{
@@ -77,72 +92,164 @@ var getWatchlistCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiRoot := viper.GetString("api-root")
 
-		isRaw, err := cmd.Flags().GetBool("raw")
+		opts, err := getOutputOptions(cmd)
 		if err != nil {
 			return err
 		}
 
-		return getWatchlistAction(os.Stdout, apiRoot, args, isRaw)
+		ropts, err := getReleaseOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		return getWatchlistAction(stdout, apiRoot, args, ropts, opts)
 	},
 }
 
-func getWatchlistAction(out io.Writer, apiRoot string, args []string, isRaw bool) error {
+func getWatchlistAction(out io.Writer, apiRoot string, args []string, ropts releaseOptions, opts outputOptions) error {
 
 	url := fmt.Sprintf("%s/account/null", apiRoot)
 
 	mediaType := args[0]
 
+	genres, match, err := loadGenres(apiRoot, mediaType, opts)
+	if err != nil {
+		return err
+	}
+
 	if mediaType == "movies" {
-		resp, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, mediaType)
+		if !ropts.enabled() {
+			return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.WatchlistMoviesResponse, account.WatchlistMovie]{
+				get: func() (*account.WatchlistMoviesResponse, error) {
+					return account.GetWatchlist[*account.WatchlistMoviesResponse](url, mediaType, opts.language)
+				},
+				pages: func(fn func(*account.WatchlistMoviesResponse) error) error {
+					return account.WatchlistPages(url, mediaType, opts.language, fn)
+				},
+				results: func(resp *account.WatchlistMoviesResponse) *[]account.WatchlistMovie { return &resp.Results },
+				print: func(out io.Writer, resp *account.WatchlistMoviesResponse, from int) error {
+					return printWatchlistMovies(out, resp, genres, nil, from, opts.locale)
+				},
+				columns: mediaColumns,
+			})
+		}
+
+		// The regional releases of all the movies are needed to filter and
+		// sort them
+		resp, err := account.GetWatchlist[*account.WatchlistMoviesResponse](url, mediaType, opts.language)
 		if err != nil {
 			return err
 		}
 
-		if isRaw {
-			return printResp(out, resp)
+		results := resp.Results[:0]
+		for _, r := range resp.Results {
+			if match(r.GenreIds) {
+				results = append(results, r)
+			}
 		}
+		resp.Results = results
 
-		return printWatchlistMovies(out, resp)
-	}
+		ids := make([]int, 0, len(resp.Results))
+		for _, r := range resp.Results {
+			ids = append(ids, r.ID)
+		}
 
-	resp, err := account.GetWatchlist[*account.WatchlistTvResponse](url, mediaType)
-	if err != nil {
-		return err
+		local, matchRelease, err := getLocalReleases(apiRoot, ids, ropts)
+		if err != nil {
+			return err
+		}
+
+		results = resp.Results[:0]
+		for _, r := range resp.Results {
+			if matchRelease(r.ID) {
+				results = append(results, r)
+			}
+		}
+		resp.Results = results
+
+		if ropts.sortBy != "" {
+			desc := strings.HasSuffix(ropts.sortBy, ":desc")
+			sort.SliceStable(resp.Results, func(i, j int) bool {
+				return local.less(resp.Results[i].ID, resp.Results[j].ID, desc)
+			})
+		}
+
+		if err := resolveImages(apiRoot, opts, resp); err != nil {
+			return err
+		}
+
+		sortMedia(resp.Results, genres, opts.sortBy)
+
+		// The regional releases are only shown by the list layout
+		if opts.tabular() && (len(opts.columns) > 0 || !opts.human()) {
+			return printColumns(out, opts, resp.Results, genres, selectColumns(opts, mediaColumns, mediaFieldNames))
+		}
+
+		if !opts.human() {
+			return render(out, opts, resp)
+		}
+
+		return printWatchlistMovies(out, resp, genres, local, 0, opts.locale)
 	}
 
-	if isRaw {
-		return printResp(out, resp)
+	if ropts.enabled() {
+		return errors.New("release filters only apply to movies")
 	}
 
-	return printWatchlistTv(out, resp)
+	return showMediaList(out, apiRoot, opts, genres, match, mediaList[*account.WatchlistTvResponse, account.WatchlistTv]{
+		get: func() (*account.WatchlistTvResponse, error) {
+			return account.GetWatchlist[*account.WatchlistTvResponse](url, mediaType, opts.language)
+		},
+		pages: func(fn func(*account.WatchlistTvResponse) error) error {
+			return account.WatchlistPages(url, mediaType, opts.language, fn)
+		},
+		results: func(resp *account.WatchlistTvResponse) *[]account.WatchlistTv { return &resp.Results },
+		print: func(out io.Writer, resp *account.WatchlistTvResponse, from int) error {
+			return printWatchlistTv(out, resp, genres, from, opts.locale)
+		},
+		columns: mediaColumns,
+	})
 }
 
-func printWatchlistMovies(out io.Writer, resp *account.WatchlistMoviesResponse) error {
+func printWatchlistMovies(out io.Writer, resp *account.WatchlistMoviesResponse, genres genre.Names, local *localReleases, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
-	fmt.Fprint(w, "Watchlist Movies:\n")
+	if resp.Page <= 1 {
+		fmt.Fprint(w, loc.T("Watchlist Movies:\n"))
+	}
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Title: %s\n", r.Title)
-		fmt.Fprintf(w, "Release Date: %s\n", r.ReleaseDate)
-		fmt.Fprintf(w, "Popularity: %f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Title: %s\n", r.DisplayTitle())
+		loc.Fprintf(w, "Release Date: %s\n", loc.Date(r.ReleaseDate))
+		if local != nil {
+			l := local.byID[r.ID]
+			loc.Fprintf(w, "%s: %s\n", local.label(loc), dash(loc.Date(l.Date)))
+			loc.Fprintf(w, "Certification: %s\n", dash(l.Certification))
+		}
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 6))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 6))
 	}
 	return w.Flush()
 }
 
-func printWatchlistTv(out io.Writer, resp *account.WatchlistTvResponse) error {
+func printWatchlistTv(out io.Writer, resp *account.WatchlistTvResponse, genres genre.Names, from int, loc *i18n.Locale) error {
 	w := tabwriter.NewWriter(out, 3, 2, 0, ' ', 0)
 	results := resp.Results
-	fmt.Fprint(w, "Watchlist TV Shows:\n")
+	if resp.Page <= 1 {
+		fmt.Fprint(w, loc.T("Watchlist TV Shows:\n"))
+	}
 	for i, r := range results {
-		fmt.Fprintf(w, "%d. ", i+1)
-		fmt.Fprintf(w, "Name: %s\n", r.Name)
-		fmt.Fprintf(w, "First Air Date: %s\n", r.FirstAirDate)
-		fmt.Fprintf(w, "Popularity: %f\n", r.Popularity)
-		fmt.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
-		fmt.Fprintf(w, "Vote Average: %f\n\n", r.VoteAverage)
+		fmt.Fprintf(w, "%d. ", from+i+1)
+		loc.Fprintf(w, "Name: %s\n", r.DisplayName())
+		loc.Fprintf(w, "First Air Date: %s\n", loc.Date(r.FirstAirDate))
+		loc.Fprintf(w, "Genres: %s\n", genres.Join(r.GenreIds))
+		loc.Fprintf(w, "Poster: %s\n", r.PosterPath)
+		loc.Fprintf(w, "Popularity: %s\n", loc.Float(r.Popularity, 6))
+		loc.Fprintf(w, "Vote Count: %d\n", r.VoteCount)
+		loc.Fprintf(w, "Vote Average: %s\n\n", loc.Float(r.VoteAverage, 6))
 	}
 	return w.Flush()
 }
@@ -158,7 +265,14 @@ func init() {
 	// and all subcommands, e.g.:
 	// watchlistCmd.PersistentFlags().String("foo", "", "A help for foo")
 
-	getWatchlistCmd.Flags().BoolP("raw", "r", false, "Print raw json output")
+	getWatchlistCmd.Flags().BoolP("raw", "r", false, rawUsage)
+	getWatchlistCmd.Flags().StringSlice("genre", nil, "Only show results having all these genres")
+	getWatchlistCmd.Flags().String("release-type", "", "Regional release date to use: theatrical or digital (default theatrical)")
+	getWatchlistCmd.Flags().String("released-after", "", "Only show movies released in your region on or after this date, YYYY-MM-DD")
+	getWatchlistCmd.Flags().String("released-before", "", "Only show movies released in your region on or before this date, YYYY-MM-DD")
+	getWatchlistCmd.Flags().String("max-certification", "", "Only show movies certified up to this level in your region, e.g. PG-13")
+	getWatchlistCmd.Flags().StringSlice("columns", nil, columnsUsage)
+	getWatchlistCmd.Flags().String("sort-by", "", "Sort on a --columns field, field:desc for descending order, or movies by release-date[:desc] in your region")
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
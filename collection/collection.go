@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type partResults struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
+}
+
+type DetailsResponse struct {
+	ID           int           `json:"id"`
+	Name         string        `json:"name"`
+	Overview     string        `json:"overview"`
+	PosterPath   string        `json:"poster_path"`
+	BackdropPath string        `json:"backdrop_path"`
+	Parts        []partResults `json:"parts"`
+}
+
+// GetDetails fetches the collection at url along with the movies it holds,
+// their titles in language
+func GetDetails(url, language string) (*DetailsResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.PosterPath = url("poster", r.PosterPath)
+	r.BackdropPath = url("backdrop", r.BackdropPath)
+	for i := range r.Parts {
+		r.Parts[i].PosterPath = url("poster", r.Parts[i].PosterPath)
+		r.Parts[i].BackdropPath = url("backdrop", r.Parts[i].BackdropPath)
+	}
+}
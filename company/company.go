@@ -0,0 +1,111 @@
+package company
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type parentCompany struct {
+	ID       int    `json:"id"`
+	LogoPath string `json:"logo_path"`
+	Name     string `json:"name"`
+}
+
+type DetailsResponse struct {
+	Description   string         `json:"description"`
+	Headquarters  string         `json:"headquarters"`
+	Homepage      string         `json:"homepage"`
+	ID            int            `json:"id"`
+	LogoPath      string         `json:"logo_path"`
+	Name          string         `json:"name"`
+	OriginCountry string         `json:"origin_country"`
+	ParentCompany *parentCompany `json:"parent_company"`
+}
+
+type movieResults struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
+}
+
+type MoviesResponse struct {
+	Page         int            `json:"page"`
+	Results      []movieResults `json:"results"`
+	TotalPages   int            `json:"total_pages"`
+	TotalResults int            `json:"total_results"`
+}
+
+// GetDetails fetches the company at url
+func GetDetails(url string) (*DetailsResponse, error) {
+
+	respByte, err := sendRequest(url, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetMovies fetches a page of the movies produced by companyID, most popular
+// first, their titles in language. TMDB has no company movies endpoint, they
+// are discovered instead
+func GetMovies(apiRoot string, companyID, page int, language string) (*MoviesResponse, error) {
+
+	u := fmt.Sprintf("%s/discover/movie?language=%s&page=%d&sort_by=popularity.desc&with_companies=%d",
+		apiRoot, language, page, companyID)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *MoviesResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ResolveImages replaces the logo paths with the URLs returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.LogoPath = url("logo", r.LogoPath)
+	if r.ParentCompany != nil {
+		r.ParentCompany.LogoPath = url("logo", r.ParentCompany.LogoPath)
+	}
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *MoviesResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Results {
+		r.Results[i].PosterPath = url("poster", r.Results[i].PosterPath)
+		r.Results[i].BackdropPath = url("backdrop", r.Results[i].BackdropPath)
+	}
+}
@@ -0,0 +1,161 @@
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrInvalidSize = errors.New("invalid image size")
+)
+
+var sendRequest = client.SendRequestContext
+
+// cacheAge is how long the configuration is reused, TMDB recommends
+// refreshing it every few days
+const cacheAge = 24 * time.Hour
+
+type images struct {
+	BaseURL       string   `json:"base_url"`
+	SecureBaseURL string   `json:"secure_base_url"`
+	BackdropSizes []string `json:"backdrop_sizes"`
+	LogoSizes     []string `json:"logo_sizes"`
+	PosterSizes   []string `json:"poster_sizes"`
+	ProfileSizes  []string `json:"profile_sizes"`
+	StillSizes    []string `json:"still_sizes"`
+}
+
+type Response struct {
+	ChangeKeys []string `json:"change_keys"`
+	Images     images   `json:"images"`
+}
+
+func Get(ctx context.Context, url string) (*Response, error) {
+
+	respByte, err := sendRequest(ctx, url, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Load returns the API configuration, using the local cache when it is
+// fresh enough
+func Load(ctx context.Context, apiRoot string) (*Response, error) {
+	key := cache.Key("configuration", apiRoot)
+
+	if data, ok := cache.Get(key, cacheAge); ok {
+		var resp *Response
+		if err := json.Unmarshal(data, &resp); err == nil && resp.Images.SecureBaseURL != "" {
+			return resp, nil
+		}
+	}
+
+	resp, err := Get(ctx, fmt.Sprintf("%s/configuration", apiRoot))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Images.SecureBaseURL == "" {
+		return nil, fmt.Errorf("%w: missing images base URL", client.ErrInvalidResponse)
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	// A broken cache only costs another request, don't fail on it
+	_ = cache.Put(key, data)
+
+	return resp, nil
+}
+
+// Sizes returns the sizes available for an image kind: poster, backdrop,
+// still, profile or logo
+func (r *Response) Sizes(kind string) []string {
+	switch kind {
+	case "poster":
+		return r.Images.PosterSizes
+	case "backdrop":
+		return r.Images.BackdropSizes
+	case "still":
+		return r.Images.StillSizes
+	case "profile":
+		return r.Images.ProfileSizes
+	case "logo":
+		return r.Images.LogoSizes
+	}
+	return nil
+}
+
+// Size picks the size to request for an image kind. An exact match is used
+// as is, otherwise a width such as w400 selects the smallest available width
+// not narrower than it, falling back to "original"
+func (r *Response) Size(kind, size string) (string, error) {
+	if size == "" || size == "original" {
+		return "original", nil
+	}
+
+	sizes := r.Sizes(kind)
+	for _, s := range sizes {
+		if s == size {
+			return s, nil
+		}
+	}
+
+	want, err := width(size)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSize, size)
+	}
+
+	best, bestWidth := "original", 0
+	for _, s := range sizes {
+		w, err := width(s)
+		if err != nil || w < want {
+			continue
+		}
+		if bestWidth == 0 || w < bestWidth {
+			best, bestWidth = s, w
+		}
+	}
+
+	return best, nil
+}
+
+func width(size string) (int, error) {
+	if !strings.HasPrefix(size, "w") {
+		return 0, ErrInvalidSize
+	}
+	return strconv.Atoi(size[1:])
+}
+
+// ImageURL returns the absolute URL of an image. Empty paths and paths that
+// are already absolute are returned unchanged
+func (r *Response) ImageURL(kind, path, size string) (string, error) {
+	if path == "" || strings.HasPrefix(path, "http") {
+		return path, nil
+	}
+
+	s, err := r.Size(kind, size)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(r.Images.SecureBaseURL, "/") + "/" + s + path, nil
+}
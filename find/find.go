@@ -0,0 +1,94 @@
+package find
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequestContext
+
+// External sources accepted by Get
+const (
+	SourceIMDb = "imdb_id"
+	SourceTVDB = "tvdb_id"
+)
+
+type movieResults struct {
+	ID            int    `json:"id"`
+	Title         string `json:"title"`
+	OriginalTitle string `json:"original_title"`
+	ReleaseDate   string `json:"release_date"`
+	MediaType     string `json:"media_type"`
+}
+
+type tvResults struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	OriginalName string `json:"original_name"`
+	FirstAirDate string `json:"first_air_date"`
+	MediaType    string `json:"media_type"`
+}
+
+type personResults struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	KnownForDepartment string `json:"known_for_department"`
+	MediaType          string `json:"media_type"`
+}
+
+type Response struct {
+	MovieResults  []movieResults  `json:"movie_results"`
+	TvResults     []tvResults     `json:"tv_results"`
+	PersonResults []personResults `json:"person_results"`
+}
+
+// Get looks up the TMDB entries matching externalID in source, either
+// SourceIMDb or SourceTVDB, their titles and names in language
+func Get(ctx context.Context, apiRoot, externalID, source, language string) (*Response, error) {
+
+	u := fmt.Sprintf("%s/find/%s?external_source=%s&language=%s",
+		apiRoot, neturl.PathEscape(externalID), source, language)
+
+	respByte, err := sendRequest(ctx, u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// IDs returns the TMDB ids found for mediaType: movie, tv or person
+func (r *Response) IDs(mediaType string) []int {
+	var ids []int
+	switch mediaType {
+	case "movie":
+		for _, m := range r.MovieResults {
+			ids = append(ids, m.ID)
+		}
+	case "tv":
+		for _, t := range r.TvResults {
+			ids = append(ids, t.ID)
+		}
+	case "person":
+		for _, p := range r.PersonResults {
+			ids = append(ids, p.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,171 @@
+package genre
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/dummyheaad/tmdbCLI/cache"
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrUnknownGenre = errors.New("unknown genre")
+)
+
+var sendRequest = client.SendRequestContext
+
+// cacheAge is how long a downloaded genre list is reused, genres barely
+// ever change on TMDB
+const cacheAge = 7 * 24 * time.Hour
+
+type genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ListResponse struct {
+	Genres []genre `json:"genres"`
+}
+
+// GetList fetches the official genres for movies or TV shows
+func GetList(ctx context.Context, url, language string) (*ListResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	respByte, err := sendRequest(ctx, u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *ListResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Names maps genre ids to their names
+type Names map[int]string
+
+// Load returns the genres for mediaType (movie or tv) in the given
+// language, using the local cache when it is fresh enough
+func Load(ctx context.Context, apiRoot, mediaType, language string) (Names, error) {
+	key := cache.Key("genre", mediaType, language, apiRoot)
+
+	var resp *ListResponse
+
+	if data, ok := cache.Get(key, cacheAge); ok {
+		if err := json.Unmarshal(data, &resp); err != nil {
+			resp = nil
+		}
+	}
+
+	if resp == nil {
+		var err error
+		url := fmt.Sprintf("%s/genre/%s/list", apiRoot, mediaType)
+		if resp, err = GetList(ctx, url, language); err != nil {
+			return nil, err
+		}
+
+		if len(resp.Genres) > 0 {
+			data, err := json.Marshal(resp)
+			if err != nil {
+				return nil, err
+			}
+			// A broken cache only costs another request, don't fail on it
+			_ = cache.Put(key, data)
+		}
+	}
+
+	names := make(Names, len(resp.Genres))
+	for _, g := range resp.Genres {
+		names[g.ID] = g.Name
+	}
+
+	return names, nil
+}
+
+// Join returns the comma separated names of ids. Ids missing from the
+// registry are printed as is
+func (n Names) Join(ids []int) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := n[id]
+		if !ok {
+			name = strconv.Itoa(id)
+		}
+		s = append(s, name)
+	}
+	return strings.Join(s, ", ")
+}
+
+// Sorted returns all genre names in alphabetical order
+func (n Names) Sorted() []string {
+	s := make([]string, 0, len(n))
+	for _, name := range n {
+		s = append(s, name)
+	}
+	sort.Strings(s)
+	return s
+}
+
+// List returns the genres in the shape of the TMDB response, sorted by name
+func (n Names) List() *ListResponse {
+	resp := &ListResponse{Genres: make([]genre, 0, len(n))}
+	for id, name := range n {
+		resp.Genres = append(resp.Genres, genre{ID: id, Name: name})
+	}
+	sort.Slice(resp.Genres, func(i, j int) bool {
+		a, b := resp.Genres[i], resp.Genres[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+	return resp
+}
+
+// Matcher returns a function reporting whether a list of genre ids contains
+// all the given genre names, compared case insensitively
+func (n Names) Matcher(names []string) (func(ids []int) bool, error) {
+	want := make([]int, 0, len(names))
+
+	for _, name := range names {
+		found := false
+		for id, g := range n {
+			if strings.EqualFold(strings.TrimSpace(name), g) {
+				want = append(want, id)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownGenre, name)
+		}
+	}
+
+	return func(ids []int) bool {
+		for _, w := range want {
+			found := false
+			for _, id := range ids {
+				if id == w {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
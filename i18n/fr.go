@@ -0,0 +1,152 @@
+package i18n
+
+// French follows the French typography, a space going before the colons
+var French = &Locale{
+	Tag:        "fr",
+	Decimal:    ",",
+	DateLayout: "02/01/2006",
+	Messages: map[string]string{
+		"Favorite Movies:\n":    "Films favoris :\n",
+		"Favorite TV Shows:\n":  "Séries favorites :\n",
+		"Watchlist Movies:\n":   "Films à voir :\n",
+		"Watchlist TV Shows:\n": "Séries à voir :\n",
+		"Items:\n":              "Éléments :\n",
+
+		"Title: %s\n":          "Titre : %s\n",
+		"Name: %s\n":           "Nom : %s\n",
+		"Release Date: %s\n":   "Date de sortie : %s\n",
+		"First Air Date: %s\n": "Première diffusion : %s\n",
+		"Air Date: %s\n":       "Date de diffusion : %s\n",
+		"Date: %s\n":           "Date : %s\n",
+		"Genres: %s\n":         "Genres : %s\n",
+		"Poster: %s\n":         "Affiche : %s\n",
+		"Still: %s\n":          "Image : %s\n",
+		"Popularity: %s\n":     "Popularité : %s\n",
+		"Vote Count: %d\n":     "Nombre de votes : %d\n",
+		"Vote Average: %s\n\n": "Note moyenne : %s\n\n",
+		"Eps Number: %d\n":     "Épisode : %d\n",
+		"Certification: %s\n":  "Classification : %s\n",
+		"ID: %d\n":             "ID : %d\n",
+		"List: %s\n":           "Liste : %s\n",
+		"Description: %s\n":    "Description : %s\n",
+		"Created By: %s\n":     "Créée par : %s\n",
+		"Total Items: %d\n":    "Nombre d'éléments : %d\n",
+		"%s: %s\n":             "%s : %s\n",
+
+		"Account details for %d\n":  "Détails du compte %d\n",
+		"Username: %s\n":            "Nom d'utilisateur : %s\n",
+		"Avatar: %s\n":              "Avatar : %s\n",
+		"Lists:\n":                  "Listes :\n",
+		"List Type: %s\n":           "Type de liste : %s\n",
+		"Total Items: %d\n\n":       "Nombre d'éléments : %d\n\n",
+		"Movie %d in list %s: %s\n": "Film %d dans la liste %s : %s\n",
+		"Genres:\n":                 "Genres :\n",
+		"Overview: %s\n":            "Résumé : %s\n",
+		"Rating: %s\n":              "Note : %s\n",
+		"Vote Average: %s\n":        "Note moyenne : %s\n",
+		"yes":                       "oui",
+		"no":                        "non",
+
+		"Account state for %s %d\n":         "État du compte pour %s %d\n",
+		"Account state for episode %d\n":    "État du compte pour l'épisode %d\n",
+		"Favorite: %s\n":                    "Favori : %s\n",
+		"Watchlist: %s\n":                   "À voir : %s\n",
+		"ID\tFavorite\tWatchlist\tRating\n": "ID\tFavori\tÀ voir\tNote\n",
+
+		"Changes for %s from %s to %s\n":    "Modifications de %s du %s au %s\n",
+		"Watchlist changes from %s to %s\n": "Modifications de la liste à voir du %s au %s\n",
+		"Time\tKey\tAction\tValue\n":        "Heure\tClé\tAction\tValeur\n",
+		"No changes\n":                      "Aucune modification\n",
+
+		"Collection details for %d\n": "Détails de la collection %d\n",
+		"Movies:\n":                   "Films :\n",
+		"Rated: %d/%d, Favorite: %d, Watchlist: %d, Missing: %d\n": "Notés : %d/%d, favoris : %d, à voir : %d, manquants : %d\n",
+		"ID\tTitle\tRelease Date\tStatus\n":                        "ID\tTitre\tDate de sortie\tStatut\n",
+		"rated":                                                    "noté",
+		"favorite":                                                 "favori",
+		"watchlist":                                                "à voir",
+		"missing":                                                  "manquant",
+
+		"Company details for %d\n":               "Détails de la société %d\n",
+		"Network details for %d\n":               "Détails de la chaîne %d\n",
+		"Headquarters: %s\n":                     "Siège : %s\n",
+		"Origin Country: %s\n":                   "Pays d'origine : %s\n",
+		"Homepage: %s\n":                         "Site web : %s\n",
+		"Parent Company: %s\n":                   "Société mère : %s\n",
+		"Logo: %s\n":                             "Logo : %s\n",
+		"Movies by company %d (page %d of %d)\n": "Films de la société %d (page %d sur %d)\n",
+
+		"Images:\n":   "Images :\n",
+		"Error: %s\n": "Erreur : %s\n",
+		"Downloaded: %d, Skipped: %d, Failed: %d\n": "Téléchargées : %d, ignorées : %d, en échec : %d\n",
+		"Manifest: %s\n": "Manifeste : %s\n",
+
+		"Keywords for %s %d\n":           "Mots-clés de %s %d\n",
+		"Alternative titles for %s %d\n": "Titres alternatifs de %s %d\n",
+		"Country\tTitle\tType\n":         "Pays\tTitre\tType\n",
+		"Translations for %s %d\n":       "Traductions de %s %d\n",
+		"Title (%s): %s\n":               "Titre (%s) : %s\n",
+		"Language\tName\tTitle\n":        "Langue\tNom\tTitre\n",
+
+		"Person details for %d\n": "Détails de la personne %d\n",
+		"Known For: %s\n":         "Connu pour : %s\n",
+		"Birthday: %s\n":          "Date de naissance : %s\n",
+		"Deathday: %s\n":          "Date de décès : %s\n",
+		"Place of Birth: %s\n":    "Lieu de naissance : %s\n",
+		"Profile: %s\n":           "Photo : %s\n",
+		"Biography: %s\n":         "Biographie : %s\n",
+		"Credits for %d\n":        "Filmographie de %d\n",
+		"Cast:\n":                 "Interprétation :\n",
+		"Crew:\n":                 "Équipe technique :\n",
+		"Media Type: %s\n":        "Type de média : %s\n",
+		"Character: %s\n":         "Personnage : %s\n",
+		"Department: %s\n":        "Département : %s\n",
+		"Job: %s\n":               "Poste : %s\n",
+
+		"Watch providers for %s %d in %s\n": "Fournisseurs de %s %d en %s\n",
+		"Watchlist providers in %s\n":       "Fournisseurs de la liste à voir en %s\n",
+		"Link: %s\n":                        "Lien : %s\n",
+		"%s:\n":                             "%s :\n",
+		"Not Available:\n":                  "Non disponible :\n",
+		"Stream":                            "Streaming",
+		"Rent":                              "Location",
+		"Buy":                               "Achat",
+		"Free":                              "Gratuit",
+		"Ads":                               "Avec publicité",
+
+		"Recommended Movies:\n":    "Films recommandés :\n",
+		"Score: %s\n":              "Score : %s\n",
+		"Because you liked %s\n\n": "Parce que vous avez aimé %s\n\n",
+
+		"Release dates for movie %d\n":              "Dates de sortie du film %d\n",
+		"Region\tDate\tType\tCertification\tNote\n": "Région\tDate\tType\tClassification\tNote\n",
+		"Certifications for %s in %s\n":             "Classifications de %s en %s\n",
+		"Certification\tMeaning\n":                  "Classification\tSignification\n",
+		"Theatrical Release (%s)":                   "Sortie en salles (%s)",
+		"Digital Release (%s)":                      "Sortie numérique (%s)",
+		"Premiere":                                  "Avant-première",
+		"Theatrical (limited)":                      "Cinéma (limitée)",
+		"Theatrical":                                "Cinéma",
+		"Digital":                                   "Numérique",
+		"Physical":                                  "Physique",
+		"TV":                                        "TV",
+
+		"Reviews for %s %d (page %d of %d, %d reviews)\n": "Critiques de %s %d (page %d sur %d, %d critiques)\n",
+		"Author: %s\n":  "Auteur : %s\n",
+		"Created: %s\n": "Publiée le : %s\n",
+		"URL: %s\n":     "URL : %s\n",
+		"%s[...] use --full to read the whole review\n": "%s[...] utilisez --full pour lire toute la critique\n",
+
+		"ID":           "ID",
+		"Title":        "Titre",
+		"Year":         "Année",
+		"Date":         "Date",
+		"Genres":       "Genres",
+		"Overview":     "Résumé",
+		"Poster":       "Affiche",
+		"Popularity":   "Popularité",
+		"Vote Average": "Note moyenne",
+		"Vote Count":   "Votes",
+		"Rating":       "Ma note",
+	},
+}
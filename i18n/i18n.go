@@ -0,0 +1,127 @@
+package i18n
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Locale translates the messages of the CLI output and formats the numbers
+// and dates the way a language does. The nil Locale is English
+type Locale struct {
+	// Tag is the ISO 639-1 code of the language, e.g. fr
+	Tag string
+	// Decimal separates the integer part of the numbers from the fraction
+	Decimal string
+	// DateLayout is the time layout of the dates
+	DateLayout string
+	// Messages maps the English messages, or printf formats, to their
+	// translation. The missing ones are printed in English
+	Messages map[string]string
+}
+
+// English is the language of the messages in the code
+var English = &Locale{
+	Tag:        "en",
+	Decimal:    ".",
+	DateLayout: time.DateOnly,
+}
+
+var locales = map[string]*Locale{
+	English.Tag: English,
+	French.Tag:  French,
+}
+
+// Languages returns the tags of the supported languages
+func Languages() []string {
+	tags := make([]string, 0, len(locales))
+	for t := range locales {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Lookup returns the locale of name, a language tag such as fr or fr-CA or
+// a POSIX locale such as fr_FR.UTF-8, and whether it is supported
+func Lookup(name string) (*Locale, bool) {
+	// Drop the encoding and modifier, e.g. .UTF-8 or @euro, then the region
+	name, _, _ = strings.Cut(name, ".")
+	name, _, _ = strings.Cut(name, "@")
+	lang, _, _ := strings.Cut(strings.ReplaceAll(name, "_", "-"), "-")
+
+	l, ok := locales[strings.ToLower(lang)]
+	return l, ok
+}
+
+// Select returns the locale of lang, the --ui-language value, or else of
+// the first of $LC_ALL, $LC_MESSAGES and $LANG set. An unsupported lang is
+// an error, the environment falling back to English
+func Select(lang string) (*Locale, error) {
+	if lang != "" {
+		l, ok := Lookup(lang)
+		if !ok {
+			return nil, fmt.Errorf("unsupported language %q, use one of %s", lang,
+				strings.Join(Languages(), ", "))
+		}
+		return l, nil
+	}
+
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			if l, ok := Lookup(v); ok {
+				return l, nil
+			}
+			break
+		}
+	}
+
+	return English, nil
+}
+
+func (l *Locale) get() *Locale {
+	if l == nil {
+		return English
+	}
+	return l
+}
+
+// T returns the translation of msg, msg itself when it has none
+func (l *Locale) T(msg string) string {
+	if t, ok := l.get().Messages[msg]; ok {
+		return t
+	}
+	return msg
+}
+
+// Sprintf formats args with the translation of format
+func (l *Locale) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(l.T(format), args...)
+}
+
+// Fprintf writes args formatted with the translation of format to w
+func (l *Locale) Fprintf(w io.Writer, format string, args ...any) (int, error) {
+	return fmt.Fprintf(w, l.T(format), args...)
+}
+
+// Float formats v with prec decimals
+func (l *Locale) Float(v float64, prec int) string {
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if d := l.get().Decimal; d != "." {
+		s = strings.Replace(s, ".", d, 1)
+	}
+	return s
+}
+
+// Date formats a YYYY-MM-DD date, returned as is when it isn't one
+func (l *Locale) Date(date string) string {
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return date
+	}
+	return t.Format(l.get().DateLayout)
+}
@@ -0,0 +1,158 @@
+package i18n
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	testCases := []struct {
+		name   string
+		lang   string
+		expTag string
+		expOK  bool
+	}{
+		{name: "Tag", lang: "fr", expTag: "fr", expOK: true},
+		{name: "Region", lang: "fr-CA", expTag: "fr", expOK: true},
+		{name: "Posix", lang: "fr_FR.UTF-8", expTag: "fr", expOK: true},
+		{name: "Modifier", lang: "fr_BE@euro", expTag: "fr", expOK: true},
+		{name: "UpperCase", lang: "EN_us", expTag: "en", expOK: true},
+		{name: "Unsupported", lang: "de_DE", expOK: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, ok := Lookup(tc.lang)
+
+			if ok != tc.expOK {
+				t.Fatalf("Expected supported %t, got %t.", tc.expOK, ok)
+			}
+
+			if ok && l.Tag != tc.expTag {
+				t.Errorf("Expected language %q, got %q.", tc.expTag, l.Tag)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		flag     string
+		env      map[string]string
+		expTag   string
+		expError bool
+	}{
+		{name: "Default", expTag: "en"},
+		{name: "Flag", flag: "fr", expTag: "fr"},
+		{name: "FlagRegion", flag: "fr-CA", expTag: "fr"},
+		{name: "FlagUnsupported", flag: "de", expError: true},
+		{name: "Lang", env: map[string]string{"LANG": "fr_FR.UTF-8"}, expTag: "fr"},
+		{name: "LangModifier", env: map[string]string{"LANG": "fr_BE@euro"}, expTag: "fr"},
+		{name: "LangPosix", env: map[string]string{"LANG": "C"}, expTag: "en"},
+		{name: "LangUnsupported", env: map[string]string{"LANG": "de_DE.UTF-8"}, expTag: "en"},
+		{name: "LcAllFirst", env: map[string]string{"LC_ALL": "en_US.UTF-8", "LANG": "fr_FR.UTF-8"}, expTag: "en"},
+		{name: "LcMessages", env: map[string]string{"LC_MESSAGES": "fr_FR", "LANG": "en_US"}, expTag: "fr"},
+		{name: "FlagFirst", flag: "en", env: map[string]string{"LANG": "fr_FR.UTF-8"}, expTag: "en"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+				t.Setenv(env, tc.env[env])
+			}
+
+			l, err := Select(tc.flag)
+
+			if tc.expError {
+				if err == nil {
+					t.Fatal("Expected error, got nil.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if l.Tag != tc.expTag {
+				t.Errorf("Expected language %q, got %q.", tc.expTag, l.Tag)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	testCases := []struct {
+		name   string
+		locale *Locale
+		msg    string
+		expOut string
+	}{
+		{name: "Nil", msg: "Title: %s\n", expOut: "Title: %s\n"},
+		{name: "English", locale: English, msg: "Title: %s\n", expOut: "Title: %s\n"},
+		{name: "French", locale: French, msg: "Title: %s\n", expOut: "Titre : %s\n"},
+		{name: "FrenchMissing", locale: French, msg: "Missing: %s\n", expOut: "Missing: %s\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := tc.locale.T(tc.msg); tc.expOut != out {
+				t.Errorf("Expected %q, got %q.", tc.expOut, out)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		name    string
+		locale  *Locale
+		expDate string
+		expNum  string
+	}{
+		{name: "Nil", expDate: "1999-10-15", expNum: "8.43"},
+		{name: "English", locale: English, expDate: "1999-10-15", expNum: "8.43"},
+		{name: "French", locale: French, expDate: "15/10/1999", expNum: "8,43"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := tc.locale.Date("1999-10-15"); tc.expDate != out {
+				t.Errorf("Expected date %q, got %q.", tc.expDate, out)
+			}
+
+			if out := tc.locale.Float(8.433, 2); tc.expNum != out {
+				t.Errorf("Expected number %q, got %q.", tc.expNum, out)
+			}
+
+			// Only the decimal separator changes
+			if out := tc.locale.Float(1234, 0); out != "1234" {
+				t.Errorf("Expected number %q, got %q.", "1234", out)
+			}
+
+			for _, d := range []string{"", "not a date", "1999-10-15T00:00:00Z"} {
+				if out := tc.locale.Date(d); out != d {
+					t.Errorf("Expected %q as is, got %q.", d, out)
+				}
+			}
+		})
+	}
+}
+
+// The translations must take the arguments of the English messages
+func TestMessageVerbs(t *testing.T) {
+	verbs := regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+	for _, tag := range Languages() {
+		l, _ := Lookup(tag)
+		for msg, translation := range l.Messages {
+			exp := strings.Join(verbs.FindAllString(msg, -1), " ")
+			got := strings.Join(verbs.FindAllString(translation, -1), " ")
+			if exp != got {
+				t.Errorf("%s: expected the verbs %q in the translation of %q, got %q.", tag, exp, msg, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package images
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+var (
+	ErrDownload     = errors.New("download failed")
+	ErrInvalidName  = errors.New("invalid file name")
+	ErrInvalidLimit = errors.New("invalid concurrency limit")
+)
+
+const (
+	StatusDownloaded = "downloaded"
+	StatusSkipped    = "skipped"
+	StatusFailed     = "failed"
+)
+
+// DefaultTemplate names the files after their media type, id and kind
+const DefaultTemplate = "{{.MediaType}}-{{.ID}}-{{.Kind}}{{.Ext}}"
+
+// Item is an image to download
+type Item struct {
+	MediaType string `json:"media_type"`
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Kind      string `json:"kind"`
+	Size      string `json:"size"`
+	URL       string `json:"url"`
+}
+
+// Entry records the outcome of an Item download in the manifest
+type Entry struct {
+	Item
+	File   string `json:"file"`
+	Bytes  int64  `json:"bytes"`
+	SHA256 string `json:"sha256"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Manifest struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Dir        string    `json:"dir"`
+	Downloaded int       `json:"downloaded"`
+	Skipped    int       `json:"skipped"`
+	Failed     int       `json:"failed"`
+	Entries    []Entry   `json:"entries"`
+}
+
+// Options controls where and how the images are downloaded
+type Options struct {
+	Dir          string
+	Template     string
+	Concurrency  int
+	ManifestName string
+}
+
+// nameData is the data available to the file name template
+type nameData struct {
+	Item
+	Slug string
+	Ext  string
+}
+
+func newClient() *http.Client {
+	c := &http.Client{
+		Timeout: 60 * time.Second,
+	}
+	return c
+}
+
+// slug turns a title into a lower case, dash separated file name fragment
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// fileName renders the file name of item, it must stay inside the
+// destination directory
+func fileName(tmpl *template.Template, item Item) (string, error) {
+	var b bytes.Buffer
+
+	data := nameData{
+		Item: item,
+		Slug: slug(item.Title),
+		Ext:  path.Ext(item.URL),
+	}
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	name := filepath.Clean(filepath.FromSlash(b.String()))
+	if name == "." || filepath.IsAbs(name) ||
+		name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, b.String())
+	}
+
+	return name, nil
+}
+
+// LoadManifest reads a manifest written by a previous download. A missing
+// manifest is not an error
+func LoadManifest(file string) (*Manifest, error) {
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Manifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func hashFile(file string) (int64, string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// isPresent reports whether file already holds the image. Files recorded in
+// the previous manifest are checked against their size and hash, unknown
+// files against the size announced by the server
+func isPresent(c *http.Client, file string, item Item, prev map[string]Entry) (int64, string, bool) {
+	info, err := os.Stat(file)
+	if err != nil || !info.Mode().IsRegular() {
+		return 0, "", false
+	}
+
+	size, sum, err := hashFile(file)
+	if err != nil {
+		return 0, "", false
+	}
+
+	if e, ok := prev[file]; ok && e.URL == item.URL && e.SHA256 != "" {
+		return size, sum, e.Bytes == size && e.SHA256 == sum
+	}
+
+	r, err := c.Head(item.URL)
+	if err != nil {
+		return 0, "", false
+	}
+	r.Body.Close()
+
+	if r.StatusCode != http.StatusOK || r.ContentLength < 0 {
+		return 0, "", false
+	}
+
+	return size, sum, r.ContentLength == size
+}
+
+// fetch downloads url into file through a temporary file, so an interrupted
+// download never leaves a truncated image behind
+func fetch(c *http.Client, url, file string) (int64, string, error) {
+	r, err := c.Get(url)
+	if err != nil {
+		return 0, "", err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return 0, "", fmt.Errorf("%w: %s: %s", ErrDownload, url, r.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return 0, "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".download-*")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, h), r.Body)
+	if err != nil {
+		tmp.Close()
+		return 0, "", err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, "", err
+	}
+
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return 0, "", err
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Download fetches items into opts.Dir running at most opts.Concurrency
+// downloads at once, and writes the manifest next to them. Images already
+// present are skipped. Failed downloads are recorded in the manifest and
+// reported with ErrDownload once all the items have been processed
+func Download(items []Item, opts Options) (*Manifest, error) {
+	if opts.Concurrency < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidLimit, opts.Concurrency)
+	}
+
+	if opts.Template == "" {
+		opts.Template = DefaultTemplate
+	}
+
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(opts.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifestFile := filepath.Join(opts.Dir, opts.ManifestName)
+
+	prevManifest, err := LoadManifest(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := make(map[string]Entry, len(prevManifest.Entries))
+	for _, e := range prevManifest.Entries {
+		prev[filepath.Join(opts.Dir, filepath.FromSlash(e.File))] = e
+	}
+
+	m := &Manifest{
+		CreatedAt: time.Now().UTC(),
+		Dir:       opts.Dir,
+		Entries:   make([]Entry, len(items)),
+	}
+
+	// Resolve all the file names upfront, a bad template must not leave
+	// a partial download behind
+	for i, item := range items {
+		name, err := fileName(tmpl, item)
+		if err != nil {
+			return nil, err
+		}
+		m.Entries[i] = Entry{Item: item, File: filepath.ToSlash(name)}
+	}
+
+	c := newClient()
+	sem := make(chan struct{}, opts.Concurrency)
+
+	// Two items may resolve to the same file, serialize them
+	var locks sync.Map
+
+	var wg sync.WaitGroup
+	for i := range m.Entries {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(e *Entry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			file := filepath.Join(opts.Dir, filepath.FromSlash(e.File))
+
+			l, _ := locks.LoadOrStore(file, &sync.Mutex{})
+			l.(*sync.Mutex).Lock()
+			defer l.(*sync.Mutex).Unlock()
+
+			if size, sum, ok := isPresent(c, file, e.Item, prev); ok {
+				e.Bytes, e.SHA256, e.Status = size, sum, StatusSkipped
+				return
+			}
+
+			size, sum, err := fetch(c, e.URL, file)
+			if err != nil {
+				e.Status, e.Error = StatusFailed, err.Error()
+				return
+			}
+
+			e.Bytes, e.SHA256, e.Status = size, sum, StatusDownloaded
+		}(&m.Entries[i])
+	}
+	wg.Wait()
+
+	for _, e := range m.Entries {
+		switch e.Status {
+		case StatusDownloaded:
+			m.Downloaded++
+		case StatusSkipped:
+			m.Skipped++
+		case StatusFailed:
+			m.Failed++
+		}
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(manifestFile, data, 0o644); err != nil {
+		return nil, err
+	}
+
+	if m.Failed > 0 {
+		return m, fmt.Errorf("%w: %d of %d images", ErrDownload, m.Failed, len(items))
+	}
+
+	return m, nil
+}
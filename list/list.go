@@ -0,0 +1,243 @@
+package list
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/account"
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type StatusResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+}
+
+type CreateResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	ListID        int    `json:"list_id"`
+}
+
+type ItemStatusResponse struct {
+	ID          interface{} `json:"id"`
+	ItemPresent bool        `json:"item_present"`
+}
+
+// Item is a movie or TV show of a list
+type Item struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	GenreIds         []int    `json:"genre_ids"`
+	ID               int      `json:"id"`
+	MediaType        string   `json:"media_type"`
+	OriginCountry    []string `json:"origin_country,omitempty"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalTitle    string   `json:"original_title,omitempty"`
+	OriginalName     string   `json:"original_name,omitempty"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	ReleaseDate      string   `json:"release_date,omitempty"`
+	FirstAirDate     string   `json:"first_air_date,omitempty"`
+	Title            string   `json:"title,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	Video            bool     `json:"video"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+}
+
+// DisplayTitle returns the movie title or the TV show name
+func (i Item) DisplayTitle() string {
+	if i.Title != "" {
+		return i.Title
+	}
+	return i.Name
+}
+
+// Date returns the release date of a movie or the first air date of a TV show
+func (i Item) Date() string {
+	if i.ReleaseDate != "" {
+		return i.ReleaseDate
+	}
+	return i.FirstAirDate
+}
+
+// Media returns the common view of the movie or TV show
+func (i Item) Media() account.Media {
+	return account.Media{
+		ID:          i.ID,
+		Title:       i.DisplayTitle(),
+		Date:        i.Date(),
+		GenreIds:    i.GenreIds,
+		Overview:    i.Overview,
+		PosterPath:  i.PosterPath,
+		Popularity:  i.Popularity,
+		VoteAverage: i.VoteAverage,
+		VoteCount:   i.VoteCount,
+	}
+}
+
+type DetailsResponse struct {
+	CreatedBy     string      `json:"created_by"`
+	Description   string      `json:"description"`
+	FavoriteCount int         `json:"favorite_count"`
+	ID            interface{} `json:"id"`
+	Iso6391       string      `json:"iso_639_1"`
+	ItemCount     int         `json:"item_count"`
+	Items         []Item      `json:"items"`
+	Name          string      `json:"name"`
+	Page          int         `json:"page"`
+	PosterPath    string      `json:"poster_path"`
+	TotalPages    int         `json:"total_pages"`
+	TotalResults  int         `json:"total_results"`
+}
+
+func decode[T any](respByte []byte) (*T, error) {
+	var resp *T
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Create makes a new list, url is the list endpoint
+func Create(url, name, description, language string) (*CreateResponse, error) {
+
+	list := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Language    string `json:"language"`
+	}{
+		Name:        name,
+		Description: description,
+		Language:    language,
+	}
+
+	var body bytes.Buffer
+
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, err
+	}
+
+	respByte, err := sendRequest(url, http.MethodPost, "application/json", http.StatusCreated, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[CreateResponse](respByte)
+}
+
+// GetDetails fetches a page of the list at url along with its items, the
+// titles in language
+func GetDetails(url string, page int, language string) (*DetailsResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s&page=%d", url, language, page)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[DetailsResponse](respByte)
+}
+
+// Pages passes the pages of the list at url to fn as they are received,
+// the titles in language, see client.EachPage
+func Pages(url, language string, fn func(page *DetailsResponse) error) error {
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	return client.EachPage(context.Background(), u, func(page *DetailsResponse) int { return page.TotalPages }, fn)
+}
+
+// Delete removes the list at url
+func Delete(url string) (*StatusResponse, error) {
+
+	respByte, err := sendRequest(url, http.MethodDelete, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[StatusResponse](respByte)
+}
+
+func changeItem(url, action string, mediaID int, expStatus int) (*StatusResponse, error) {
+
+	u := fmt.Sprintf("%s/%s", url, action)
+
+	item := struct {
+		MediaID int `json:"media_id"`
+	}{
+		MediaID: mediaID,
+	}
+
+	var body bytes.Buffer
+
+	if err := json.NewEncoder(&body).Encode(item); err != nil {
+		return nil, err
+	}
+
+	respByte, err := sendRequest(u, http.MethodPost, "application/json", expStatus, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[StatusResponse](respByte)
+}
+
+// AddItem adds a movie to the list at url
+func AddItem(url string, mediaID int) (*StatusResponse, error) {
+	return changeItem(url, "add_item", mediaID, http.StatusCreated)
+}
+
+// RemoveItem removes a movie from the list at url
+func RemoveItem(url string, mediaID int) (*StatusResponse, error) {
+	return changeItem(url, "remove_item", mediaID, http.StatusOK)
+}
+
+// Clear removes all the items of the list at url
+func Clear(url string) (*StatusResponse, error) {
+
+	u := fmt.Sprintf("%s/clear?confirm=true", url)
+
+	respByte, err := sendRequest(u, http.MethodPost, "", http.StatusCreated, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[StatusResponse](respByte)
+}
+
+// ItemStatus reports whether a movie is part of the list at url
+func ItemStatus(url string, movieID int) (*ItemStatusResponse, error) {
+
+	u := fmt.Sprintf("%s/item_status?movie_id=%d", url, movieID)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[ItemStatusResponse](respByte)
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.PosterPath = url("poster", r.PosterPath)
+	for i := range r.Items {
+		r.Items[i].PosterPath = url("poster", r.Items[i].PosterPath)
+		r.Items[i].BackdropPath = url("backdrop", r.Items[i].BackdropPath)
+	}
+}
@@ -0,0 +1,85 @@
+package movie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var (
+	sendRequest        = client.SendRequest
+	sendRequestContext = client.SendRequestContext
+)
+
+type genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type collection struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	PosterPath   string `json:"poster_path"`
+	BackdropPath string `json:"backdrop_path"`
+}
+
+type DetailsResponse struct {
+	Adult               bool        `json:"adult"`
+	BackdropPath        string      `json:"backdrop_path"`
+	BelongsToCollection *collection `json:"belongs_to_collection"`
+	Budget              int         `json:"budget"`
+	Genres              []genre     `json:"genres"`
+	Homepage            string      `json:"homepage"`
+	ID                  int         `json:"id"`
+	ImdbID              string      `json:"imdb_id"`
+	OriginalLanguage    string      `json:"original_language"`
+	OriginalTitle       string      `json:"original_title"`
+	Overview            string      `json:"overview"`
+	Popularity          float64     `json:"popularity"`
+	PosterPath          string      `json:"poster_path"`
+	ReleaseDate         string      `json:"release_date"`
+	Revenue             int         `json:"revenue"`
+	Runtime             int         `json:"runtime"`
+	Status              string      `json:"status"`
+	Tagline             string      `json:"tagline"`
+	Title               string      `json:"title"`
+	Video               bool        `json:"video"`
+	VoteAverage         float64     `json:"vote_average"`
+	VoteCount           int         `json:"vote_count"`
+}
+
+// DisplayTitle returns the title in the requested language, or the original
+// title when TMDB has no translation
+func (r *DetailsResponse) DisplayTitle() string {
+	if r.Title != "" {
+		return r.Title
+	}
+	return r.OriginalTitle
+}
+
+// GetDetails fetches the details of the movie at url, the title and
+// overview in language
+func GetDetails(url, language string) (*DetailsResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
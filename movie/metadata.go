@@ -0,0 +1,97 @@
+package movie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type KeywordsResponse struct {
+	ID       int       `json:"id"`
+	Keywords []keyword `json:"keywords"`
+}
+
+type altTitle struct {
+	ISO_3166_1 string `json:"iso_3166_1"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+}
+
+type AltTitlesResponse struct {
+	ID     int        `json:"id"`
+	Titles []altTitle `json:"titles"`
+}
+
+type translationData struct {
+	Homepage string `json:"homepage"`
+	Overview string `json:"overview"`
+	Runtime  int    `json:"runtime"`
+	Tagline  string `json:"tagline"`
+	Title    string `json:"title"`
+}
+
+type translation struct {
+	ISO_3166_1  string          `json:"iso_3166_1"`
+	ISO_639_1   string          `json:"iso_639_1"`
+	Name        string          `json:"name"`
+	EnglishName string          `json:"english_name"`
+	Data        translationData `json:"data"`
+}
+
+// Language returns the language tag of the translation, e.g. en-US
+func (t translation) Language() string {
+	return fmt.Sprintf("%s-%s", t.ISO_639_1, t.ISO_3166_1)
+}
+
+type TranslationsResponse struct {
+	ID           int           `json:"id"`
+	Translations []translation `json:"translations"`
+}
+
+func get(u string, resp any) error {
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(bytes.NewReader(respByte)).Decode(resp)
+}
+
+// GetKeywords fetches the keywords of the movie at url
+func GetKeywords(url string) (*KeywordsResponse, error) {
+	var resp *KeywordsResponse
+	if err := get(fmt.Sprintf("%s/keywords", url), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAltTitles fetches the alternative titles of the movie at url, only
+// the ones of country when it isn't empty
+func GetAltTitles(url, country string) (*AltTitlesResponse, error) {
+	u := fmt.Sprintf("%s/alternative_titles", url)
+	if country != "" {
+		u = fmt.Sprintf("%s?country=%s", u, country)
+	}
+
+	var resp *AltTitlesResponse
+	if err := get(u, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetTranslations fetches the translations of the movie at url
+func GetTranslations(url string) (*TranslationsResponse, error) {
+	var resp *TranslationsResponse
+	if err := get(fmt.Sprintf("%s/translations", url), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
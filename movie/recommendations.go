@@ -0,0 +1,61 @@
+package movie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type listResults struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
+}
+
+type ListResponse struct {
+	Page         int           `json:"page"`
+	Results      []listResults `json:"results"`
+	TotalPages   int           `json:"total_pages"`
+	TotalResults int           `json:"total_results"`
+}
+
+func getList(url, kind, language string, page int) (*ListResponse, error) {
+
+	u := fmt.Sprintf("%s/%s?language=%s&page=%d", url, kind, language, page)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *ListResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetRecommendations fetches a page of the movies recommended for the movie
+// at url, their titles in language
+func GetRecommendations(url, language string, page int) (*ListResponse, error) {
+	return getList(url, "recommendations", language, page)
+}
+
+// GetSimilar fetches a page of the movies similar to the movie at url, their
+// titles in language
+func GetSimilar(url, language string, page int) (*ListResponse, error) {
+	return getList(url, "similar", language, page)
+}
@@ -0,0 +1,153 @@
+package movie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var ErrInvalidReleaseType = errors.New("invalid release type")
+
+// Release types, as numbered by TMDB
+const (
+	ReleasePremiere = iota + 1
+	ReleaseTheatricalLimited
+	ReleaseTheatrical
+	ReleaseDigital
+	ReleasePhysical
+	ReleaseTV
+)
+
+var releaseTypeNames = map[int]string{
+	ReleasePremiere:          "Premiere",
+	ReleaseTheatricalLimited: "Theatrical (limited)",
+	ReleaseTheatrical:        "Theatrical",
+	ReleaseDigital:           "Digital",
+	ReleasePhysical:          "Physical",
+	ReleaseTV:                "TV",
+}
+
+type releaseDate struct {
+	Certification string   `json:"certification"`
+	Descriptors   []string `json:"descriptors"`
+	ISO_639_1     string   `json:"iso_639_1"`
+	Note          string   `json:"note"`
+	ReleaseDate   string   `json:"release_date"`
+	Type          int      `json:"type"`
+}
+
+// Date returns the day of the release, YYYY-MM-DD
+func (r releaseDate) Date() string {
+	d, _, _ := strings.Cut(r.ReleaseDate, "T")
+	return d
+}
+
+// TypeName returns the name of the release type
+func (r releaseDate) TypeName() string {
+	if name, ok := releaseTypeNames[r.Type]; ok {
+		return name
+	}
+	return fmt.Sprint(r.Type)
+}
+
+type regionReleases struct {
+	ISO_3166_1   string        `json:"iso_3166_1"`
+	ReleaseDates []releaseDate `json:"release_dates"`
+}
+
+type ReleaseDatesResponse struct {
+	ID      int              `json:"id"`
+	Results []regionReleases `json:"results"`
+}
+
+// GetReleaseDates fetches the release dates and certifications of the movie
+// at url for every region
+func GetReleaseDates(url string) (*ReleaseDatesResponse, error) {
+	return GetReleaseDatesContext(context.Background(), url)
+}
+
+// GetReleaseDatesContext is GetReleaseDates, the request being made with ctx
+func GetReleaseDatesContext(ctx context.Context, url string) (*ReleaseDatesResponse, error) {
+
+	u := fmt.Sprintf("%s/release_dates", url)
+
+	respByte, err := sendRequestContext(ctx, u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *ReleaseDatesResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReleaseTypes returns the release types matching name: theatrical, which
+// includes the limited releases, or digital
+func ReleaseTypes(name string) ([]int, error) {
+	switch name {
+	case "theatrical":
+		return []int{ReleaseTheatricalLimited, ReleaseTheatrical}, nil
+	case "digital":
+		return []int{ReleaseDigital}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrInvalidReleaseType, name)
+}
+
+// Filter keeps the release dates of the given region only
+func (r *ReleaseDatesResponse) Filter(region string) {
+	results := r.Results[:0]
+	for _, res := range r.Results {
+		if strings.EqualFold(res.ISO_3166_1, region) {
+			results = append(results, res)
+		}
+	}
+	r.Results = results
+}
+
+func (r *ReleaseDatesResponse) region(region string) []releaseDate {
+	for _, res := range r.Results {
+		if strings.EqualFold(res.ISO_3166_1, region) {
+			return res.ReleaseDates
+		}
+	}
+	return nil
+}
+
+// Date returns the earliest release date, YYYY-MM-DD, of one of types in
+// region, or an empty string when there is none
+func (r *ReleaseDatesResponse) Date(region string, types []int) string {
+	var date string
+	for _, rd := range r.region(region) {
+		for _, t := range types {
+			if rd.Type == t && (date == "" || rd.Date() < date) {
+				date = rd.Date()
+			}
+		}
+	}
+	return date
+}
+
+// Certification returns the certification of the movie in region, taken
+// from the theatrical releases when they have one
+func (r *ReleaseDatesResponse) Certification(region string) string {
+	var cert string
+	for _, rd := range r.region(region) {
+		if rd.Certification == "" {
+			continue
+		}
+		if rd.Type == ReleaseTheatrical || rd.Type == ReleaseTheatricalLimited {
+			return rd.Certification
+		}
+		if cert == "" {
+			cert = rd.Certification
+		}
+	}
+	return cert
+}
@@ -0,0 +1,46 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type DetailsResponse struct {
+	Headquarters  string `json:"headquarters"`
+	Homepage      string `json:"homepage"`
+	ID            int    `json:"id"`
+	LogoPath      string `json:"logo_path"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// GetDetails fetches the TV network at url
+func GetDetails(url string) (*DetailsResponse, error) {
+
+	respByte, err := sendRequest(url, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ResolveImages replaces the logo path with the URL returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.LogoPath = url("logo", r.LogoPath)
+}
@@ -0,0 +1,175 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Style is the list of SGR parameters of a terminal style, e.g. "1;34" for
+// bold blue. The empty style leaves the text as is
+type Style string
+
+// Paint wraps text in the escape sequences of the style
+func (s Style) Paint(text string) string {
+	if s == "" || text == "" {
+		return text
+	}
+	return "\x1b[" + string(s) + "m" + text + "\x1b[0m"
+}
+
+// Theme holds the styles of the colored output
+type Theme struct {
+	Heading Style
+	Meta    Style
+	Good    Style
+	Average Style
+	Poor    Style
+	Rating  Style
+}
+
+// DefaultTheme is used for the styles the theme configuration leaves out
+var DefaultTheme = Theme{
+	Heading: "1",
+	Meta:    "2",
+	Good:    "32",
+	Average: "33",
+	Poor:    "31",
+	Rating:  "33",
+}
+
+// styleNames are the names accepted in a theme besides SGR numbers
+var styleNames = map[string]string{
+	"bold":      "1",
+	"dim":       "2",
+	"italic":    "3",
+	"underline": "4",
+	"black":     "30",
+	"red":       "31",
+	"green":     "32",
+	"yellow":    "33",
+	"blue":      "34",
+	"magenta":   "35",
+	"cyan":      "36",
+	"white":     "37",
+	"none":      "",
+}
+
+// ParseTheme returns DefaultTheme overridden by spec, a comma separated
+// list of element=style where the elements are heading, meta, good,
+// average, poor and rating, and the styles names or SGR numbers joined
+// with +, e.g. "heading=bold+blue,good=92,meta=none"
+func ParseTheme(spec string) (Theme, error) {
+	theme := DefaultTheme
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		element, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return theme, fmt.Errorf("invalid theme entry %q, use element=style", entry)
+		}
+
+		var codes []string
+		for _, part := range strings.Split(value, "+") {
+			part = strings.ToLower(strings.TrimSpace(part))
+			if code, ok := styleNames[part]; ok {
+				if code != "" {
+					codes = append(codes, code)
+				}
+				continue
+			}
+			if n, err := strconv.Atoi(part); err != nil || n < 0 || n > 255 {
+				return theme, fmt.Errorf("invalid theme style %q", part)
+			}
+			codes = append(codes, part)
+		}
+		style := Style(strings.Join(codes, ";"))
+
+		switch strings.TrimSpace(element) {
+		case "heading":
+			theme.Heading = style
+		case "meta":
+			theme.Meta = style
+		case "good":
+			theme.Good = style
+		case "average":
+			theme.Average = style
+		case "poor":
+			theme.Poor = style
+		case "rating":
+			theme.Rating = style
+		default:
+			return theme, fmt.Errorf("invalid theme element %q", element)
+		}
+	}
+
+	return theme, nil
+}
+
+// Kind tells how the cells of a table column are colored
+type Kind int
+
+const (
+	// Plain cells are left as is
+	Plain Kind = iota
+	// Meta cells are secondary data, dimmed
+	Meta
+	// Vote cells hold a 0-10 average, colored by band
+	Vote
+	// Rating cells hold a 0-10 rating, shown as stars
+	Rating
+)
+
+// VoteStyle returns the style of a 0-10 vote average
+func (t Theme) VoteStyle(vote float64) Style {
+	switch {
+	case vote >= 7:
+		return t.Good
+	case vote >= 5:
+		return t.Average
+	}
+	return t.Poor
+}
+
+// style returns the style of a cell of kind
+func (t Theme) style(kind Kind, cell string) Style {
+	switch kind {
+	case Meta:
+		return t.Meta
+	case Rating:
+		return t.Rating
+	case Vote:
+		v, err := parseNumber(cell)
+		if err != nil {
+			return ""
+		}
+		return t.VoteStyle(v)
+	}
+	return ""
+}
+
+// decorate returns the content of a cell of kind in the colored output,
+// the ratings being shown as stars
+func decorate(kind Kind, cell string) string {
+	if kind != Rating {
+		return cell
+	}
+
+	v, err := parseNumber(cell)
+	if err != nil {
+		return cell
+	}
+
+	s, _ := stars(v)
+	return s + " " + cell
+}
+
+// parseNumber parses the number of a cell, the decimal separator being a
+// point or a comma
+func parseNumber(cell string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(cell, ",", ".", 1), 64)
+}
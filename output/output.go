@@ -0,0 +1,218 @@
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Text is the human readable format, printed by each command itself
+const Text = "text"
+
+// ErrUnknownFormat is returned for an --output value having no renderer
+var ErrUnknownFormat = errors.New("unknown output format")
+
+// Renderer writes v to w in a given format
+type Renderer func(w io.Writer, v any, o Options) error
+
+var renderers = map[string]Renderer{
+	"json":  renderJSON,
+	"jsonl": renderJSONL,
+	"yaml":  renderYAML,
+	"table": renderTable,
+	"csv":   renderCSV,
+	"tsv":   renderTSV,
+}
+
+// Formats returns the supported formats, text first
+func Formats() []string {
+	formats := make([]string, 0, len(renderers))
+	for f := range renderers {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+
+	return append([]string{Text}, formats...)
+}
+
+// Valid checks that format is supported
+func Valid(format string) error {
+	if _, ok := renderers[format]; ok || format == Text {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q, use one of %s", ErrUnknownFormat, format,
+		strings.Join(Formats(), ", "))
+}
+
+// Render writes v to w in format. The text format has no generic renderer
+// and is printed as json
+func Render(w io.Writer, format string, v any, o Options) error {
+	if format == Text {
+		format = "json"
+	}
+
+	r, ok := renderers[format]
+	if !ok {
+		return Valid(format)
+	}
+
+	return r(w, v, o)
+}
+
+func renderJSON(w io.Writer, v any, _ Options) error {
+	data, err := json.MarshalIndent(v, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func renderJSONL(w io.Writer, v any, _ Options) error {
+	return renderStream(w, "jsonl", v)
+}
+
+func renderYAML(w io.Writer, v any, _ Options) error {
+	g, err := Value(v)
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(yamlNode(g)); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
+
+// yamlNode converts the generic value v keeping the order of the keys
+func yamlNode(v any) *yaml.Node {
+	switch v := v.(type) {
+	case *Object:
+		n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, k := range v.Keys {
+			n.Content = append(n.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k},
+				yamlNode(v.Values[k]))
+		}
+		return n
+	case []any:
+		n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, e := range v {
+			n.Content = append(n.Content, yamlNode(e))
+		}
+		return n
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: Cell(v)}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}
+	}
+
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: Cell(v)}
+}
+
+// table returns the header and rows of v as printed by the table format
+func table(v any) ([]string, [][]string, error) {
+	g, err := Value(v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := Records(g)
+
+	columns := Columns(records)
+	header := columns
+	if len(columns) == 0 {
+		header = []string{"value"}
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		rows = append(rows, Row(r, columns))
+	}
+
+	return header, rows, nil
+}
+
+func renderTable(w io.Writer, v any, o Options) error {
+	header, rows, err := table(v)
+	if err != nil {
+		return err
+	}
+
+	t := Table{Header: header, Rows: rows}
+	return t.Render(w, o)
+}
+
+func renderCSV(w io.Writer, v any, _ Options) error {
+	return renderStream(w, "csv", v)
+}
+
+func renderTSV(w io.Writer, v any, _ Options) error {
+	return renderStream(w, "tsv", v)
+}
+
+// renderStream prints all the records of v at once in a streaming format
+func renderStream(w io.Writer, format string, v any) error {
+	s, err := NewStream(w, format)
+	if err != nil {
+		return err
+	}
+	if err := s.Write(v); err != nil {
+		return err
+	}
+	return s.Close()
+}
+
+// RenderResults writes the outputs of a query in format. The text format
+// prints one output per line, strings without quotes, and json one
+// document per output as jq does. The other formats render a single output
+// as is and several ones as a list
+func RenderResults(w io.Writer, format string, results []any, o Options) error {
+	switch format {
+	case Text:
+		for _, r := range results {
+			line := Cell(r)
+			if r == nil {
+				line = "null"
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "json":
+		for _, r := range results {
+			if err := renderJSON(w, r, o); err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(results) == 1 {
+		return Render(w, format, results[0], o)
+	}
+	if results == nil {
+		results = []any{}
+	}
+	return Render(w, format, results, o)
+}
@@ -0,0 +1,1204 @@
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is returned for a --query expression that can't be parsed
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Query is a compiled jq-like expression. It supports paths (.results[0],
+// .["title"], .[2:5]), iteration (.[]), pipes, commas, comparisons, and/or,
+// arithmetic, array and object construction ([.x], {title, year: .y}) and
+// the functions length, keys, has, select, map, sort_by, first, last,
+// reverse, join, tostring, tonumber, contains, startswith, ascii_downcase,
+// not and empty, but not the optional operator ?
+type Query struct {
+	expr string
+	eval evalFunc
+}
+
+// evalFunc returns the outputs of an expression for one input
+type evalFunc func(v any) ([]any, error)
+
+// String returns the expression of the query
+func (q *Query) String() string {
+	return q.expr
+}
+
+// Run evaluates the query against the generic value v, see Value
+func (q *Query) Run(v any) ([]any, error) {
+	return q.eval(v)
+}
+
+// ParseQuery compiles the query expression expr
+func ParseQuery(expr string) (*Query, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks}
+	eval, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek().text)
+	}
+
+	return &Query{expr: expr, eval: eval}, nil
+}
+
+// Token kinds
+const (
+	tokPunct = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokField
+)
+
+type token struct {
+	kind int
+	text string
+}
+
+func lex(expr string) ([]token, error) {
+	var toks []token
+
+	r := []rune(expr)
+	for i := 0; i < len(r); {
+		c := r[i]
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(r) && r[j] != '"' {
+				if r[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(r) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidQuery)
+			}
+			s, err := strconv.Unquote(string(r[i : j+1]))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidQuery, err)
+			}
+			toks = append(toks, token{tokString, s})
+			i = j + 1
+		case c == '.' && i+1 < len(r) && isIdentStart(r[i+1]):
+			j := i + 1
+			for j < len(r) && isIdent(r[j]) {
+				j++
+			}
+			toks = append(toks, token{tokField, string(r[i+1 : j])})
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(r) && (unicode.IsDigit(r[j]) || r[j] == '.' || r[j] == 'e' || r[j] == 'E') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, string(r[i:j])})
+			i = j
+		case isIdentStart(c):
+			j := i
+			for j < len(r) && isIdent(r[j]) {
+				j++
+			}
+			toks = append(toks, token{tokIdent, string(r[i:j])})
+			i = j
+		case c == '?':
+			return nil, fmt.Errorf("%w: the optional operator \"?\" is not supported", ErrInvalidQuery)
+		default:
+			op := string(c)
+			if i+1 < len(r) {
+				if two := string(r[i : i+2]); two == "==" || two == "!=" || two == "<=" || two == ">=" {
+					op = two
+				}
+			}
+			if !strings.Contains(".[]|,(){}:<>=!+-*/", string(c)) || op == "=" || op == "!" {
+				return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidQuery, op)
+			}
+			toks = append(toks, token{tokPunct, op})
+			i += len(op)
+		}
+	}
+
+	return toks, nil
+}
+
+func isIdentStart(c rune) bool {
+	return c == '_' || unicode.IsLetter(c)
+}
+
+func isIdent(c rune) bool {
+	return isIdentStart(c) || unicode.IsDigit(c)
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.toks)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{tokPunct, ""}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// accept consumes the punctuation or keyword s when it comes next
+func (p *parser) accept(s string) bool {
+	t := p.peek()
+	if !p.done() && (t.kind == tokPunct || t.kind == tokIdent) && t.text == s {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		if p.done() {
+			return p.errorf("expected %q at the end", s)
+		}
+		return p.errorf("expected %q, got %q", s, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidQuery, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) parsePipe() (evalFunc, error) {
+	left, err := p.parseComma()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("|") {
+		right, err := p.parseComma()
+		if err != nil {
+			return nil, err
+		}
+		left = pipe(left, right)
+	}
+
+	return left, nil
+}
+
+func pipe(left, right evalFunc) evalFunc {
+	return func(v any) ([]any, error) {
+		in, err := left(v)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []any
+		for _, x := range in {
+			r, err := right(x)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, r...)
+		}
+		return out, nil
+	}
+}
+
+func (p *parser) parseComma() (evalFunc, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(",") {
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(v any) ([]any, error) {
+			a, err := l(v)
+			if err != nil {
+				return nil, err
+			}
+			b, err := right(v)
+			if err != nil {
+				return nil, err
+			}
+			return append(a, b...), nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOr() (evalFunc, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) {
+			return truthy(a) || truthy(b), nil
+		})
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (evalFunc, error) {
+	left, err := p.parseCompare()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("and") {
+		right, err := p.parseCompare()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) {
+			return truthy(a) && truthy(b), nil
+		})
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseCompare() (evalFunc, error) {
+	left, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.accept(op) {
+			continue
+		}
+
+		right, err := p.parseAdd()
+		if err != nil {
+			return nil, err
+		}
+
+		return binary(left, right, func(a, b any) (any, error) {
+			c := compare(a, b)
+			switch op {
+			case "==":
+				return c == 0, nil
+			case "!=":
+				return c != 0, nil
+			case "<=":
+				return c <= 0, nil
+			case ">=":
+				return c >= 0, nil
+			case "<":
+				return c < 0, nil
+			}
+			return c > 0, nil
+		}), nil
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAdd() (evalFunc, error) {
+	left, err := p.parseMul()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokPunct || (op != "+" && op != "-") || !p.accept(op) {
+			return left, nil
+		}
+
+		right, err := p.parseMul()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) {
+			return arithmetic(op, a, b)
+		})
+	}
+}
+
+func (p *parser) parseMul() (evalFunc, error) {
+	left, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokPunct || (op != "*" && op != "/") || !p.accept(op) {
+			return left, nil
+		}
+
+		right, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) {
+			return arithmetic(op, a, b)
+		})
+	}
+}
+
+// binary evaluates op over every combination of the outputs of left and
+// right
+func binary(left, right evalFunc, op func(a, b any) (any, error)) evalFunc {
+	return func(v any) ([]any, error) {
+		a, err := left(v)
+		if err != nil {
+			return nil, err
+		}
+		b, err := right(v)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []any
+		for _, y := range b {
+			for _, x := range a {
+				r, err := op(x, y)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	}
+}
+
+func (p *parser) parsePostfix() (evalFunc, error) {
+	term, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch t := p.peek(); {
+		case t.kind == tokField:
+			p.next()
+			term = pipe(term, field(t.text))
+		case t.kind == tokPunct && t.text == "[":
+			p.next()
+			suffix, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			term = pipe(term, suffix)
+		case t.kind == tokPunct && t.text == "." && p.pos+1 < len(p.toks) && p.toks[p.pos+1].text == "[":
+			// .a.[0] is the same as .a[0]
+			p.next()
+		case t.kind == tokPunct && t.text == "." && p.pos+1 < len(p.toks) && p.toks[p.pos+1].kind == tokString:
+			// ."a b"
+			p.next()
+			term = pipe(term, field(p.next().text))
+		default:
+			return term, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (evalFunc, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokField:
+		return field(t.text), nil
+	case tokString:
+		s := t.text
+		return func(any) ([]any, error) { return []any{s}, nil }, nil
+	case tokNumber:
+		if _, err := strconv.ParseFloat(t.text, 64); err != nil {
+			return nil, p.errorf("invalid number %q", t.text)
+		}
+		n := json.Number(t.text)
+		return func(any) ([]any, error) { return []any{n}, nil }, nil
+	case tokIdent:
+		return p.parseFunction(t.text)
+	}
+
+	switch t.text {
+	case ".":
+		if p.peek().kind == tokString {
+			return field(p.next().text), nil
+		}
+		return func(v any) ([]any, error) { return []any{v}, nil }, nil
+	case "(":
+		e, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	case "[":
+		if p.accept("]") {
+			return func(any) ([]any, error) { return []any{[]any{}}, nil }, nil
+		}
+		e, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return func(v any) ([]any, error) {
+			r, err := e(v)
+			if err != nil {
+				return nil, err
+			}
+			if r == nil {
+				r = []any{}
+			}
+			return []any{r}, nil
+		}, nil
+	case "{":
+		return p.parseObject()
+	case "-":
+		e, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		return pipe(e, func(v any) ([]any, error) {
+			r, err := arithmetic("-", json.Number("0"), v)
+			return []any{r}, err
+		}), nil
+	case "":
+		return nil, p.errorf("unexpected end")
+	}
+
+	return nil, p.errorf("unexpected %q", t.text)
+}
+
+// parseBracket parses what follows [ in a path: ], index], start:end]
+func (p *parser) parseBracket() (evalFunc, error) {
+	if p.accept("]") {
+		return iterate, nil
+	}
+
+	var start, end evalFunc
+	var err error
+
+	if !p.accept(":") {
+		if start, err = p.parsePipe(); err != nil {
+			return nil, err
+		}
+		if p.accept("]") {
+			return index(start), nil
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+	}
+
+	if !p.accept("]") {
+		if end, err = p.parsePipe(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+
+	return slice(start, end), nil
+}
+
+func (p *parser) parseObject() (evalFunc, error) {
+	type entry struct {
+		key   evalFunc
+		value evalFunc
+	}
+
+	var entries []entry
+
+	for !p.accept("}") {
+		if len(entries) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+
+		var e entry
+
+		t := p.next()
+		switch {
+		case t.kind == tokIdent || t.kind == tokString:
+			k := t.text
+			e.key = func(any) ([]any, error) { return []any{k}, nil }
+			e.value = field(k)
+		case t.kind == tokPunct && t.text == "(":
+			key, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			e.key = key
+		default:
+			return nil, p.errorf("invalid object key %q", t.text)
+		}
+
+		if p.accept(":") {
+			v, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			e.value = v
+		} else if e.value == nil {
+			return nil, p.errorf("expected \":\" after the object key")
+		}
+
+		entries = append(entries, e)
+	}
+
+	return func(v any) ([]any, error) {
+		objs := []*Object{NewObject()}
+
+		for _, e := range entries {
+			keys, err := e.key(v)
+			if err != nil {
+				return nil, err
+			}
+			values, err := e.value(v)
+			if err != nil {
+				return nil, err
+			}
+
+			// Every combination of keys and values makes an object
+			var next []*Object
+			for _, o := range objs {
+				for _, k := range keys {
+					ks, ok := k.(string)
+					if !ok {
+						return nil, fmt.Errorf("object keys must be strings, got %s", typeName(k))
+					}
+					for _, val := range values {
+						c := &Object{Keys: append([]string(nil), o.Keys...), Values: map[string]any{}}
+						for kk, vv := range o.Values {
+							c.Values[kk] = vv
+						}
+						c.Set(ks, val)
+						next = append(next, c)
+					}
+				}
+			}
+			objs = next
+		}
+
+		out := make([]any, len(objs))
+		for i, o := range objs {
+			out[i] = o
+		}
+		return out, nil
+	}, nil
+}
+
+func (p *parser) parseFunction(name string) (evalFunc, error) {
+	switch name {
+	case "true", "false":
+		b := name == "true"
+		return func(any) ([]any, error) { return []any{b}, nil }, nil
+	case "null":
+		return func(any) ([]any, error) { return []any{nil}, nil }, nil
+	case "empty":
+		return func(any) ([]any, error) { return nil, nil }, nil
+	case "not":
+		return func(v any) ([]any, error) { return []any{!truthy(v)}, nil }, nil
+	case "length":
+		return each(length), nil
+	case "keys":
+		return each(keys), nil
+	case "first", "last":
+		if p.peek().text == "(" {
+			arg, err := p.parseArg()
+			if err != nil {
+				return nil, err
+			}
+			return func(v any) ([]any, error) {
+				r, err := arg(v)
+				if err != nil || len(r) == 0 {
+					return nil, err
+				}
+				if name == "first" {
+					return r[:1], nil
+				}
+				return r[len(r)-1:], nil
+			}, nil
+		}
+		i := 0
+		if name == "last" {
+			i = -1
+		}
+		return index(func(any) ([]any, error) { return []any{json.Number(strconv.Itoa(i))}, nil }), nil
+	case "reverse":
+		return each(func(v any) (any, error) {
+			arr, ok := v.([]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot reverse %s", typeName(v))
+			}
+			r := make([]any, len(arr))
+			for i, x := range arr {
+				r[len(arr)-1-i] = x
+			}
+			return r, nil
+		}), nil
+	case "tostring":
+		return each(func(v any) (any, error) {
+			if s, ok := v.(string); ok {
+				return s, nil
+			}
+			data, err := json.Marshal(v)
+			return string(data), err
+		}), nil
+	case "tonumber":
+		return each(func(v any) (any, error) {
+			switch v := v.(type) {
+			case json.Number:
+				return v, nil
+			case string:
+				if _, err := strconv.ParseFloat(v, 64); err != nil {
+					return nil, fmt.Errorf("cannot parse %q as a number", v)
+				}
+				return json.Number(v), nil
+			}
+			return nil, fmt.Errorf("cannot convert %s to a number", typeName(v))
+		}), nil
+	case "ascii_downcase":
+		return each(func(v any) (any, error) {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot downcase %s", typeName(v))
+			}
+			return strings.ToLower(s), nil
+		}), nil
+	case "select", "map", "sort_by", "has", "join", "contains", "startswith":
+	default:
+		return nil, p.errorf("unknown function %q", name)
+	}
+
+	arg, err := p.parseArg()
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "select":
+		return func(v any) ([]any, error) {
+			r, err := arg(v)
+			if err != nil {
+				return nil, err
+			}
+			var out []any
+			for _, c := range r {
+				if truthy(c) {
+					out = append(out, v)
+				}
+			}
+			return out, nil
+		}, nil
+	case "map":
+		return func(v any) ([]any, error) {
+			in, err := iterate(v)
+			if err != nil {
+				return nil, err
+			}
+			out := []any{}
+			for _, x := range in {
+				r, err := arg(x)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, r...)
+			}
+			return []any{out}, nil
+		}, nil
+	case "sort_by":
+		return func(v any) ([]any, error) {
+			arr, ok := v.([]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot sort %s", typeName(v))
+			}
+			type keyed struct {
+				key []any
+				v   any
+			}
+			items := make([]keyed, len(arr))
+			for i, x := range arr {
+				k, err := arg(x)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = keyed{k, x}
+			}
+			sort.SliceStable(items, func(i, j int) bool {
+				return compare(items[i].key, items[j].key) < 0
+			})
+			out := make([]any, len(items))
+			for i, it := range items {
+				out[i] = it.v
+			}
+			return []any{out}, nil
+		}, nil
+	}
+
+	// The functions taking a value, evaluated against the input
+	return func(v any) ([]any, error) {
+		args, err := arg(v)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []any
+		for _, a := range args {
+			r, err := apply(name, v, a)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, r)
+		}
+		return out, nil
+	}, nil
+}
+
+func (p *parser) parseArg() (evalFunc, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arg, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	return arg, p.expect(")")
+}
+
+func apply(name string, v, arg any) (any, error) {
+	switch name {
+	case "has":
+		switch v := v.(type) {
+		case *Object:
+			k, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot check whether an object has a %s key", typeName(arg))
+			}
+			_, has := v.Values[k]
+			return has, nil
+		case []any:
+			i, ok := toInt(arg)
+			return ok && i >= 0 && i < len(v), nil
+		}
+		return nil, fmt.Errorf("cannot check whether %s has a key", typeName(v))
+	case "join":
+		sep, ok := arg.(string)
+		arr, isArr := v.([]any)
+		if !ok || !isArr {
+			return nil, fmt.Errorf("cannot join %s with %s", typeName(v), typeName(arg))
+		}
+		parts := make([]string, len(arr))
+		for i, x := range arr {
+			if x != nil {
+				parts[i] = Cell(x)
+			}
+		}
+		return strings.Join(parts, sep), nil
+	case "contains":
+		return contains(v, arg), nil
+	case "startswith":
+		s, ok1 := v.(string)
+		prefix, ok2 := arg.(string)
+		if !ok1 || !ok2 {
+			return nil, errors.New("startswith requires strings")
+		}
+		return strings.HasPrefix(s, prefix), nil
+	}
+
+	return nil, fmt.Errorf("unknown function %q", name)
+}
+
+// each turns a function of one value into an expression
+func each(f func(v any) (any, error)) evalFunc {
+	return func(v any) ([]any, error) {
+		r, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		return []any{r}, nil
+	}
+}
+
+func field(name string) evalFunc {
+	return func(v any) ([]any, error) {
+		switch v := v.(type) {
+		case nil:
+			return []any{nil}, nil
+		case *Object:
+			return []any{v.Values[name]}, nil
+		}
+		return nil, fmt.Errorf("cannot index %s with %q", typeName(v), name)
+	}
+}
+
+func iterate(v any) ([]any, error) {
+	switch v := v.(type) {
+	case []any:
+		return v, nil
+	case *Object:
+		out := make([]any, len(v.Keys))
+		for i, k := range v.Keys {
+			out[i] = v.Values[k]
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("cannot iterate over %s", typeName(v))
+}
+
+func index(idx evalFunc) evalFunc {
+	return func(v any) ([]any, error) {
+		keys, err := idx(v)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []any
+		for _, k := range keys {
+			if s, ok := k.(string); ok {
+				r, err := field(s)(v)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, r...)
+				continue
+			}
+
+			i, ok := toInt(k)
+			if !ok {
+				return nil, fmt.Errorf("cannot index with %s", typeName(k))
+			}
+
+			switch v := v.(type) {
+			case nil:
+				out = append(out, nil)
+			case []any:
+				if i < 0 {
+					i += len(v)
+				}
+				if i < 0 || i >= len(v) {
+					out = append(out, nil)
+				} else {
+					out = append(out, v[i])
+				}
+			default:
+				return nil, fmt.Errorf("cannot index %s with a number", typeName(v))
+			}
+		}
+		return out, nil
+	}
+}
+
+func slice(start, end evalFunc) evalFunc {
+	bound := func(e evalFunc, v any, def, n int) (int, error) {
+		if e == nil {
+			return def, nil
+		}
+		r, err := e(v)
+		if err != nil {
+			return 0, err
+		}
+		if len(r) != 1 {
+			return 0, errors.New("slice bounds must be single numbers")
+		}
+		i, ok := toInt(r[0])
+		if !ok {
+			return 0, fmt.Errorf("cannot slice with %s", typeName(r[0]))
+		}
+		if i < 0 {
+			i += n
+		}
+		return max(0, min(i, n)), nil
+	}
+
+	return func(v any) ([]any, error) {
+		var n int
+		switch v := v.(type) {
+		case nil:
+			return []any{nil}, nil
+		case []any:
+			n = len(v)
+		case string:
+			n = len([]rune(v))
+		default:
+			return nil, fmt.Errorf("cannot slice %s", typeName(v))
+		}
+
+		from, err := bound(start, v, 0, n)
+		if err != nil {
+			return nil, err
+		}
+		to, err := bound(end, v, n, n)
+		if err != nil {
+			return nil, err
+		}
+		to = max(from, to)
+
+		if s, ok := v.(string); ok {
+			return []any{string([]rune(s)[from:to])}, nil
+		}
+		return []any{v.([]any)[from:to]}, nil
+	}
+}
+
+func length(v any) (any, error) {
+	var n int
+	switch v := v.(type) {
+	case nil:
+	case string:
+		n = len([]rune(v))
+	case []any:
+		n = len(v)
+	case *Object:
+		n = len(v.Keys)
+	case json.Number:
+		f, _ := v.Float64()
+		return number(math.Abs(f)), nil
+	default:
+		return nil, fmt.Errorf("%s has no length", typeName(v))
+	}
+	return json.Number(strconv.Itoa(n)), nil
+}
+
+func keys(v any) (any, error) {
+	switch v := v.(type) {
+	case *Object:
+		k := append([]string(nil), v.Keys...)
+		sort.Strings(k)
+		out := make([]any, len(k))
+		for i, s := range k {
+			out[i] = s
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = json.Number(strconv.Itoa(i))
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("%s has no keys", typeName(v))
+}
+
+func contains(v, x any) bool {
+	switch v := v.(type) {
+	case string:
+		s, ok := x.(string)
+		return ok && strings.Contains(v, s)
+	case []any:
+		xs, ok := x.([]any)
+		if !ok {
+			return false
+		}
+		for _, want := range xs {
+			found := false
+			for _, e := range v {
+				if contains(e, want) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	case *Object:
+		xo, ok := x.(*Object)
+		if !ok {
+			return false
+		}
+		for _, k := range xo.Keys {
+			e, ok := v.Values[k]
+			if !ok || !contains(e, xo.Values[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	return compare(v, x) == 0
+}
+
+func arithmetic(op string, a, b any) (any, error) {
+	if x, ok := a.(json.Number); ok {
+		if y, ok := b.(json.Number); ok {
+			fx, _ := x.Float64()
+			fy, _ := y.Float64()
+			switch op {
+			case "+":
+				return number(fx + fy), nil
+			case "-":
+				return number(fx - fy), nil
+			case "*":
+				return number(fx * fy), nil
+			}
+			if fy == 0 {
+				return nil, errors.New("division by zero")
+			}
+			return number(fx / fy), nil
+		}
+	}
+
+	if op == "+" {
+		switch x := a.(type) {
+		case nil:
+			return b, nil
+		case string:
+			if y, ok := b.(string); ok {
+				return x + y, nil
+			}
+		case []any:
+			if y, ok := b.([]any); ok {
+				return append(append([]any{}, x...), y...), nil
+			}
+		}
+		if b == nil {
+			return a, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot apply %s to %s and %s", op, typeName(a), typeName(b))
+}
+
+func number(f float64) json.Number {
+	return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func toInt(v any) (int, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return int(math.Floor(f)), true
+}
+
+func truthy(v any) bool {
+	return v != nil && v != false
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case *Object:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// typeOrder ranks the types the way jq sorts them
+func typeOrder(v any) int {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if v {
+			return 2
+		}
+		return 1
+	case json.Number:
+		return 3
+	case string:
+		return 4
+	case []any:
+		return 5
+	}
+	return 6
+}
+
+// compare orders two generic values, following jq for the values of
+// different types
+func compare(a, b any) int {
+	if oa, ob := typeOrder(a), typeOrder(b); oa != ob {
+		return oa - ob
+	}
+
+	switch a := a.(type) {
+	case json.Number:
+		fa, _ := a.Float64()
+		fb, _ := b.(json.Number).Float64()
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case []any:
+		bb := b.([]any)
+		for i := 0; i < len(a) && i < len(bb); i++ {
+			if c := compare(a[i], bb[i]); c != 0 {
+				return c
+			}
+		}
+		return len(a) - len(bb)
+	case *Object:
+		bo := b.(*Object)
+		ka, _ := keys(a)
+		kb, _ := keys(bo)
+		if c := compare(ka, kb); c != 0 {
+			return c
+		}
+		for _, k := range ka.([]any) {
+			if c := compare(a.Values[k.(string)], bo.Values[k.(string)]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,221 @@
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// queryInput is the document the queries of the tests run against
+const queryInput = `{
+	"page": 1,
+	"results": [
+		{"id": 550, "title": "Fight Club", "vote_average": 8.4, "genre_ids": [18, 53], "rating": null},
+		{"id": 11, "title": "Star Wars", "vote_average": 8.2, "genre_ids": [12, 28, 878]},
+		{"id": 555, "title": "Absolut", "vote_average": 5.9, "genre_ids": []}
+	],
+	"name": "Favorites"
+}`
+
+// runQuery parses expr and runs it against queryInput, the outputs being
+// returned as compact JSON documents joined by spaces
+func runQuery(t *testing.T, expr string) (string, error) {
+	t.Helper()
+
+	q, err := ParseQuery(expr)
+	if err != nil {
+		return "", err
+	}
+
+	v, err := Decode([]byte(queryInput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := q.Run(v)
+	if err != nil {
+		return "", err
+	}
+
+	docs := make([]string, len(out))
+	for i, o := range out {
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs[i] = string(data)
+	}
+	return strings.Join(docs, " "), nil
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		expMsg string
+	}{
+		{name: "Empty", expr: "", expMsg: "unexpected end"},
+		{name: "Unterminated", expr: `.["title]`, expMsg: "unterminated string"},
+		{name: "Optional", expr: ".results[]?", expMsg: `optional operator "?" is not supported`},
+		{name: "OptionalField", expr: ".name?", expMsg: `optional operator "?" is not supported`},
+		{name: "Assignment", expr: ".page = 2", expMsg: `unexpected "="`},
+		{name: "UnknownFunction", expr: "uniq", expMsg: `unknown function "uniq"`},
+		{name: "UnclosedBracket", expr: ".results[0", expMsg: `expected ":" at the end`},
+		{name: "UnclosedParen", expr: "(.page", expMsg: `expected ")" at the end`},
+		{name: "Trailing", expr: ".page )", expMsg: `unexpected ")"`},
+		{name: "MissingArg", expr: "select", expMsg: `expected "(" at the end`},
+		{name: "InvalidObjectKey", expr: "{1: .page}", expMsg: `invalid object key "1"`},
+		{name: "ObjectKeyWithoutValue", expr: `{(.name)}`, expMsg: `expected ":" after the object key`},
+		{name: "InvalidNumber", expr: "1.2.3", expMsg: `invalid number "1.2.3"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseQuery(tc.expr)
+			if err == nil {
+				t.Fatalf("Expected error, got nil.")
+			}
+
+			if !errors.Is(err, ErrInvalidQuery) {
+				t.Errorf("Expected error %q, got %q.", ErrInvalidQuery, err)
+			}
+
+			if !strings.Contains(err.Error(), tc.expMsg) {
+				t.Errorf("Expected error containing %q, got %q.", tc.expMsg, err)
+			}
+		})
+	}
+}
+
+func TestQueryRun(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		expOut string
+	}{
+		// Paths
+		{name: "Identity", expr: ".page", expOut: "1"},
+		{name: "Field", expr: ".results[0].title", expOut: `"Fight Club"`},
+		{name: "QuotedField", expr: `.["name"]`, expOut: `"Favorites"`},
+		{name: "DotQuotedField", expr: `."name"`, expOut: `"Favorites"`},
+		{name: "DotBracket", expr: ".results.[1].id", expOut: "11"},
+		{name: "NegativeIndex", expr: ".results[-1].title", expOut: `"Absolut"`},
+		{name: "IndexOutOfRange", expr: ".results[5]", expOut: "null"},
+		{name: "NegativeOutOfRange", expr: ".results[-5]", expOut: "null"},
+		{name: "Slice", expr: ".results[1:] | map(.id)", expOut: "[11,555]"},
+		{name: "SliceNegative", expr: ".results[:-1] | map(.id)", expOut: "[550,11]"},
+		{name: "SliceBothNegative", expr: ".results[-2:-1] | map(.id)", expOut: "[11]"},
+		{name: "SliceClamped", expr: ".results[-10:10] | length", expOut: "3"},
+		{name: "SliceString", expr: ".name[0:3]", expOut: `"Fav"`},
+		{name: "Iterate", expr: ".results[].id", expOut: "550 11 555"},
+		{name: "IterateObject", expr: ".results[0].genre_ids | .[]", expOut: "18 53"},
+		{name: "First", expr: "first(.results[].id)", expOut: "550"},
+		{name: "Last", expr: ".results | last | .id", expOut: "555"},
+
+		// Missing keys and null
+		{name: "MissingKey", expr: ".missing", expOut: "null"},
+		{name: "MissingNested", expr: ".missing.deeper[0]", expOut: "null"},
+		{name: "NullValue", expr: ".results[0].rating", expOut: "null"},
+		{name: "MissingVsNull", expr: ".results[] | has(\"rating\")", expOut: "true false false"},
+		{name: "NullLength", expr: ".missing | length", expOut: "0"},
+		{name: "NullSlice", expr: ".missing[1:]", expOut: "null"},
+
+		// Pipes and commas
+		{name: "Pipe", expr: ".results | length", expOut: "3"},
+		{name: "Comma", expr: ".page, .name", expOut: `1 "Favorites"`},
+		{name: "Collect", expr: "[.results[].title]", expOut: `["Fight Club","Star Wars","Absolut"]`},
+		{name: "CollectEmpty", expr: "[.results[] | select(.id > 1000)]", expOut: "[]"},
+
+		// Functions
+		{name: "Select", expr: ".results[] | select(.vote_average > 8) | .title", expOut: `"Fight Club" "Star Wars"`},
+		{name: "SelectAnd", expr: ".results[] | select(.vote_average > 8 and .id < 100) | .id", expOut: "11"},
+		{name: "SelectOr", expr: ".results[] | select(.id == 11 or .id == 555) | .id", expOut: "11 555"},
+		{name: "SelectNot", expr: ".results[] | select(.genre_ids | length == 0 | not) | .id", expOut: "550 11"},
+		{name: "SelectNone", expr: ".results[] | select(false)", expOut: ""},
+		{name: "Map", expr: ".results | map(.vote_average * 10)", expOut: "[84,82,59]"},
+		{name: "SortBy", expr: ".results | sort_by(.title) | map(.id)", expOut: "[555,550,11]"},
+		{name: "SortByNumber", expr: ".results | sort_by(.vote_average) | map(.id)", expOut: "[555,11,550]"},
+		{name: "SortByNull", expr: ".results | sort_by(.rating) | map(.id)", expOut: "[550,11,555]"},
+		{name: "Reverse", expr: ".results | map(.id) | reverse", expOut: "[555,11,550]"},
+		{name: "Keys", expr: ".results[1] | keys", expOut: `["genre_ids","id","title","vote_average"]`},
+		{name: "Join", expr: `.results | map(.title) | join(", ")`, expOut: `"Fight Club, Star Wars, Absolut"`},
+		{name: "Contains", expr: ".results[] | select(.genre_ids | contains([18])) | .id", expOut: "550"},
+		{name: "Startswith", expr: `.results[] | select(.title | startswith("Star")) | .id`, expOut: "11"},
+		{name: "Downcase", expr: ".name | ascii_downcase", expOut: `"favorites"`},
+		{name: "ToString", expr: ".page | tostring", expOut: `"1"`},
+		{name: "ToNumber", expr: `"8.5" | tonumber`, expOut: "8.5"},
+		{name: "Empty", expr: "empty", expOut: ""},
+
+		// Object construction
+		{name: "Object", expr: ".results[0] | {id, title}", expOut: `{"id":550,"title":"Fight Club"}`},
+		{name: "ObjectValues", expr: `.results[1] | {name: .title, "score": .vote_average}`, expOut: `{"name":"Star Wars","score":8.2}`},
+		{name: "ObjectComputedKey", expr: "{(.name): .page}", expOut: `{"Favorites":1}`},
+		{name: "ObjectMissing", expr: "{page, missing}", expOut: `{"page":1,"missing":null}`},
+		{name: "ObjectProduct", expr: "{id: .results[:2][].id}", expOut: `{"id":550} {"id":11}`},
+
+		// Arithmetic
+		{name: "Add", expr: ".page + 1", expOut: "2"},
+		{name: "Precedence", expr: "1 + 2 * 3 - 4 / 2", expOut: "5"},
+		{name: "Parens", expr: "(1 + 2) * 3", expOut: "9"},
+		{name: "Negate", expr: "-.page", expOut: "-1"},
+		{name: "Float", expr: ".results[0].vote_average / 2", expOut: "4.2"},
+		{name: "Concat", expr: `.name + "!"`, expOut: `"Favorites!"`},
+		{name: "ConcatArrays", expr: ".results[0].genre_ids + [1]", expOut: "[18,53,1]"},
+		{name: "AddNull", expr: ".missing + 1", expOut: "1"},
+		{name: "AddToNull", expr: `.name + null`, expOut: `"Favorites"`},
+
+		// Comparisons across types follow the jq order
+		{name: "NullLessThanNumber", expr: "null < 0", expOut: "true"},
+		{name: "NumberLessThanString", expr: `1 < "1"`, expOut: "true"},
+		{name: "StringLessThanArray", expr: `"z" < []`, expOut: "true"},
+		{name: "EqualMixed", expr: `1 == "1"`, expOut: "false"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := runQuery(t, tc.expr)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if tc.expOut != out {
+				t.Errorf("Expected output %s, got %s.", tc.expOut, out)
+			}
+		})
+	}
+}
+
+func TestQueryRunErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		expMsg string
+	}{
+		{name: "StringMinusNumber", expr: `.name - 1`, expMsg: "cannot apply - to string and number"},
+		{name: "NumberPlusString", expr: `.page + "1"`, expMsg: "cannot apply + to number and string"},
+		{name: "ArrayTimesNumber", expr: ".results * 2", expMsg: "cannot apply * to array and number"},
+		{name: "DivisionByZero", expr: ".page / 0", expMsg: "division by zero"},
+		{name: "FieldOfNumber", expr: ".page.title", expMsg: `cannot index number with "title"`},
+		{name: "IndexObject", expr: ".results[0][0]", expMsg: "cannot index object with a number"},
+		{name: "IterateNumber", expr: ".page[]", expMsg: "cannot iterate over number"},
+		{name: "SliceNumber", expr: ".page[1:]", expMsg: "cannot slice number"},
+		{name: "SortObject", expr: ".results[0] | sort_by(.id)", expMsg: "cannot sort object"},
+		{name: "JoinNumber", expr: `.page | join(",")`, expMsg: "cannot join number with string"},
+		{name: "ToNumber", expr: ".name | tonumber", expMsg: `cannot parse "Favorites" as a number`},
+		{name: "ObjectNumberKey", expr: "{(.page): 1}", expMsg: "object keys must be strings, got number"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runQuery(t, tc.expr)
+			if err == nil {
+				t.Fatalf("Expected error, got nil.")
+			}
+
+			if !strings.Contains(err.Error(), tc.expMsg) {
+				t.Errorf("Expected error containing %q, got %q.", tc.expMsg, err)
+			}
+		})
+	}
+}
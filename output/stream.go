@@ -0,0 +1,122 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Streaming reports whether format prints the records one after the other,
+// so that the results can be printed a part at a time with a Stream
+func Streaming(format string) bool {
+	switch format {
+	case "jsonl", "csv", "tsv":
+		return true
+	}
+	return false
+}
+
+// Stream prints the records of successive values, e.g. the pages of a
+// list, as they come in a streaming format. The header of csv and tsv is
+// the one of the first records: the records written are expected to have
+// the same fields, the columns the later records lack being left empty and
+// the ones they add dropped
+type Stream struct {
+	w       io.Writer
+	format  string
+	columns []string
+	started bool
+}
+
+// NewStream returns a Stream printing to w in format, one of the Streaming
+// formats
+func NewStream(w io.Writer, format string) (*Stream, error) {
+	if !Streaming(format) {
+		return nil, fmt.Errorf("%w %q, use jsonl, csv or tsv", ErrUnknownFormat, format)
+	}
+	return &Stream{w: w, format: format}, nil
+}
+
+// Write prints the records of v
+func (s *Stream) Write(v any) error {
+	g, err := Value(v)
+	if err != nil {
+		return err
+	}
+
+	records := Records(g)
+
+	if s.format == "jsonl" {
+		for _, r := range records {
+			data, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(s.w, "%s\n", data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The header waits for the first records to know the columns
+	if len(records) == 0 {
+		return nil
+	}
+
+	var rows [][]string
+	if !s.started {
+		s.columns = Columns(records)
+		rows = append(rows, s.header())
+		s.started = true
+	}
+	for _, r := range records {
+		rows = append(rows, Row(r, s.columns))
+	}
+
+	return s.writeRows(rows)
+}
+
+// Close prints the header when no records came
+func (s *Stream) Close() error {
+	if s.format == "jsonl" || s.started {
+		return nil
+	}
+
+	s.started = true
+	return s.writeRows([][]string{s.header()})
+}
+
+func (s *Stream) header() []string {
+	if len(s.columns) == 0 {
+		return []string{"value"}
+	}
+	return s.columns
+}
+
+// writeRows prints rows as csv or as tab separated values, tabs and new
+// lines inside the cells being escaped as \t and \n
+func (s *Stream) writeRows(rows [][]string) error {
+	if s.format == "csv" {
+		cw := csv.NewWriter(s.w)
+		if err := cw.WriteAll(rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	}
+
+	escape := strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\r", "\\r", "\n", "\\n")
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, c := range row {
+			cells[i] = escape.Replace(c)
+		}
+		if _, err := fmt.Fprintf(s.w, "%s\n", strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
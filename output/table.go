@@ -0,0 +1,219 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Options are the settings of the renderers
+type Options struct {
+	// Width is the number of terminal cells the tables fit in, 0 for no
+	// limit
+	Width int
+	// Wrap wraps the cells too wide for the table rather than truncating
+	// them
+	Wrap bool
+	// Color enables the styles of Theme
+	Color bool
+	Theme Theme
+}
+
+// columnGap separates the columns of a table
+const columnGap = "  "
+
+// minColumnWidth is the width below which a column isn't shrunk
+const minColumnWidth = 8
+
+// Table is a list of rows printed with aligned columns. Kinds tells how to
+// color each column, all of them being plain when it is left out
+type Table struct {
+	Header []string
+	Kinds  []Kind
+	Rows   [][]string
+}
+
+// kind returns the kind of column i
+func (t *Table) kind(i int) Kind {
+	if i < len(t.Kinds) {
+		return t.Kinds[i]
+	}
+	return Plain
+}
+
+// widths returns the width of each column, the widest ones being shrunk
+// until the table fits in limit cells
+func (t *Table) widths(limit int) []int {
+	widths := make([]int, len(t.Header))
+	for _, row := range append([][]string{t.Header}, t.Rows...) {
+		for i, c := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], Width(c))
+			}
+		}
+	}
+
+	if limit <= 0 {
+		return widths
+	}
+
+	total := func() int {
+		n := len(columnGap) * (len(widths) - 1)
+		for _, w := range widths {
+			n += w
+		}
+		return n
+	}
+
+	for total() > limit {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= minColumnWidth {
+			break
+		}
+		widths[widest]--
+	}
+
+	return widths
+}
+
+// Render prints the table, its cells being truncated or wrapped to fit in
+// o.Width
+func (t *Table) Render(w io.Writer, o Options) error {
+	s := NewTableStream(w, t.Header, t.Kinds, o)
+	if err := s.Write(t.Rows); err != nil {
+		return err
+	}
+	return s.Close()
+}
+
+// TableStream prints the rows of a table a part at a time, e.g. page after
+// page. The widths of the columns are the ones fitting the header and the
+// first rows written, the cells of the later rows too wide for them being
+// truncated or wrapped, or printed whole when o.Width is 0
+type TableStream struct {
+	w      io.Writer
+	o      Options
+	table  Table
+	widths []int
+}
+
+// NewTableStream returns a TableStream printing the table of header to w,
+// kinds telling how to color each column
+func NewTableStream(w io.Writer, header []string, kinds []Kind, o Options) *TableStream {
+	return &TableStream{w: w, o: o, table: Table{Header: header, Kinds: kinds}}
+}
+
+// Write prints rows, along with the header the first time rows come
+func (s *TableStream) Write(rows [][]string) error {
+	// The widths wait for the first rows to fit them
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if s.o.Color {
+		colored := make([][]string, len(rows))
+		for r, row := range rows {
+			colored[r] = make([]string, len(row))
+			for i, c := range row {
+				colored[r][i] = decorate(s.table.kind(i), c)
+			}
+		}
+		rows = colored
+	}
+
+	if s.widths == nil {
+		s.table.Rows = rows
+		s.widths = s.table.widths(s.o.Width)
+		s.table.Rows = nil
+
+		if err := s.printRow(s.table.Header, true); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		if err := s.printRow(row, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close prints the header when no rows came
+func (s *TableStream) Close() error {
+	if s.widths != nil {
+		return nil
+	}
+
+	s.widths = s.table.widths(s.o.Width)
+	return s.printRow(s.table.Header, true)
+}
+
+// printRow prints the lines of row, more than one when its cells are
+// wrapped
+func (s *TableStream) printRow(row []string, heading bool) error {
+	clean := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ")
+
+	// The lines of each cell, a single one unless wrapped
+	cells := make([][]string, len(s.widths))
+	styles := make([]Style, len(s.widths))
+	height := 1
+	for i, width := range s.widths {
+		c := ""
+		if i < len(row) {
+			c = row[i]
+		}
+		if s.o.Width <= 0 {
+			width = max(width, Width(c))
+		}
+
+		if s.o.Color {
+			styles[i] = s.o.Theme.Heading
+			if !heading {
+				styles[i] = s.o.Theme.style(s.table.kind(i), c)
+			}
+		}
+
+		switch {
+		case Width(c) <= width:
+			cells[i] = []string{clean.Replace(c)}
+		case s.o.Wrap:
+			cells[i] = Wrap(c, width)
+		default:
+			cells[i] = []string{Truncate(clean.Replace(c), width)}
+		}
+		height = max(height, len(cells[i]))
+	}
+
+	for l := 0; l < height; l++ {
+		var line strings.Builder
+		for i, lines := range cells {
+			c := ""
+			if l < len(lines) {
+				c = lines[l]
+			}
+			if i > 0 {
+				line.WriteString(columnGap)
+			}
+
+			padding := ""
+			if i < len(cells)-1 {
+				padding = strings.Repeat(" ", max(0, s.widths[i]-Width(c)))
+			}
+
+			// The styles apply to the text only, not to the padding
+			line.WriteString(styles[i].Paint(c) + padding)
+		}
+		if _, err := fmt.Fprintln(s.w, strings.TrimRight(line.String(), " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// dateLayouts are the date formats found in the TMDB responses
+var dateLayouts = []string{
+	time.DateOnly,
+	time.RFC3339,
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02T15:04:05.000Z",
+}
+
+// Funcs returns the helper functions available to the templates:
+//
+//	date "Jan 2, 2006" .ReleaseDate   formats a TMDB date
+//	truncate 40 .Overview             shortens a text to 40 terminal cells
+//	join ", " .Items                  joins the elements of a list
+//	stars .VoteAverage                turns a 0-10 rating into 5 stars
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"date":     formatDate,
+		"truncate": truncate,
+		"join":     join,
+		"stars":    stars,
+	}
+}
+
+// ParseTemplate parses the --template value, either the template itself or
+// @file to read it from file. funcs adds to or overrides the Funcs helpers
+func ParseTemplate(text string, funcs template.FuncMap) (*template.Template, error) {
+	name := "template"
+
+	if file, ok := strings.CutPrefix(text, "@"); ok {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		name, text = file, string(data)
+	}
+
+	return template.New(name).Funcs(Funcs()).Funcs(funcs).Parse(text)
+}
+
+func formatDate(layout string, date any) string {
+	s := fmt.Sprint(date)
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t.Format(layout)
+		}
+	}
+
+	// Left as is when it isn't a date, e.g. empty
+	return s
+}
+
+func truncate(n int, s any) string {
+	if n < 0 {
+		return fmt.Sprint(s)
+	}
+	return Truncate(fmt.Sprint(s), n)
+}
+
+func join(sep string, list any) (string, error) {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: %T isn't a list", list)
+	}
+
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return strings.Join(items, sep), nil
+}
+
+func stars(rating any) (string, error) {
+	var v float64
+
+	switch r := rating.(type) {
+	case float64:
+		v = r
+	case float32:
+		v = float64(r)
+	case int:
+		v = float64(r)
+	case json.Number:
+		f, err := r.Float64()
+		if err != nil {
+			return "", err
+		}
+		v = f
+	default:
+		f, err := strconv.ParseFloat(fmt.Sprint(rating), 64)
+		if err != nil {
+			return "", fmt.Errorf("stars: invalid rating %v", rating)
+		}
+		v = f
+	}
+
+	n := int(math.Round(math.Max(0, math.Min(10, v)) / 2))
+	return strings.Repeat("★", n) + strings.Repeat("☆", 5-n), nil
+}
@@ -0,0 +1,230 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Object is a JSON object keeping the order of its keys, so that the
+// formats other than json list the fields in the order TMDB sends them
+type Object struct {
+	Keys   []string
+	Values map[string]any
+}
+
+// Get returns the value of key and whether the object has it
+func (o *Object) Get(key string) (any, bool) {
+	v, ok := o.Values[key]
+	return v, ok
+}
+
+// Set adds or replaces the value of key
+func (o *Object) Set(key string, v any) {
+	if _, ok := o.Values[key]; !ok {
+		o.Keys = append(o.Keys, key)
+	}
+	o.Values[key] = v
+}
+
+// MarshalJSON encodes the object keeping the order of its keys
+func (o *Object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, k := range o.Keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value, err := json.Marshal(o.Values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// NewObject returns an empty object
+func NewObject() *Object {
+	return &Object{Values: map[string]any{}}
+}
+
+// Value converts v into its generic JSON representation: nil, bool,
+// json.Number, string, []any or *Object
+func Value(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(data)
+}
+
+// Decode parses the JSON document data into its generic representation,
+// see Value
+func Decode(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	v, err := decodeValue(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid JSON: trailing data")
+	}
+
+	return v, nil
+}
+
+func decodeValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := NewObject()
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			key, ok := tok.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid JSON: unexpected %v", tok)
+			}
+
+			v, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.Set(key, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			v, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+
+	return tok, nil
+}
+
+// Records returns the records of the generic value v: the elements of an
+// array, the results of a paginated response, the elements of the only
+// array of objects of v, or else v itself as a single record
+func Records(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case *Object:
+		if r, ok := v.Values["results"].([]any); ok {
+			return r
+		}
+
+		var records []any
+		found := 0
+		for _, k := range v.Keys {
+			if r, ok := v.Values[k].([]any); ok && len(r) > 0 {
+				if _, ok := r[0].(*Object); ok {
+					records = r
+					found++
+				}
+			}
+		}
+		if found == 1 {
+			return records
+		}
+	}
+
+	return []any{v}
+}
+
+// Columns returns the keys of the object records in the order they first
+// appear
+func Columns(records []any) []string {
+	var columns []string
+
+	seen := map[string]bool{}
+	for _, r := range records {
+		obj, ok := r.(*Object)
+		if !ok {
+			continue
+		}
+		for _, k := range obj.Keys {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+
+	return columns
+}
+
+// Cell formats v as the content of a table, csv or tsv cell. Scalars are
+// printed as is and nested values as compact JSON
+func Cell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// Row returns the cells of record for columns
+func Row(record any, columns []string) []string {
+	obj, ok := record.(*Object)
+	if !ok {
+		return []string{Cell(record)}
+	}
+
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		row[i] = Cell(obj.Values[c])
+	}
+	return row
+}
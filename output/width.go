@@ -0,0 +1,131 @@
+package output
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// runeWidth returns the number of terminal cells taken by r: 2 for the
+// East Asian wide and fullwidth characters, which include the emoji, 0 for
+// the combining marks and format characters such as the zero width joiner
+func runeWidth(r rune) int {
+	switch {
+	case r == 0, unicode.Is(unicode.Mn, r), unicode.Is(unicode.Me, r), unicode.Is(unicode.Cf, r):
+		return 0
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		// Skin tone modifiers are drawn along with the emoji before them
+		return 0
+	case r < 0x1100:
+		return 1
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
+
+// Width returns the number of terminal cells taken by s
+func Width(s string) int {
+	n := 0
+	joined := false
+	for _, r := range s {
+		// The emoji following a zero width joiner are drawn as one
+		if joined {
+			joined = false
+			continue
+		}
+		if r == '\u200d' {
+			joined = true
+			continue
+		}
+		n += runeWidth(r)
+	}
+	return n
+}
+
+// Truncate shortens s to at most w terminal cells, marking the cut with an
+// ellipsis
+func Truncate(s string, w int) string {
+	if Width(s) <= w {
+		return s
+	}
+	if w <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range s {
+		rw := runeWidth(r)
+		if n+rw > w-1 {
+			break
+		}
+		b.WriteRune(r)
+		n += rw
+	}
+	b.WriteString("…")
+
+	return b.String()
+}
+
+// Wrap breaks s into lines of at most w terminal cells, splitting on
+// spaces. Line breaks of s are kept and the words wider than w are cut
+func Wrap(s string, w int) []string {
+	var lines []string
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	for _, para := range strings.Split(s, "\n") {
+		var (
+			line strings.Builder
+			n    int
+		)
+		flush := func() {
+			lines = append(lines, line.String())
+			line.Reset()
+			n = 0
+		}
+
+		for _, word := range strings.FieldsFunc(para, unicode.IsSpace) {
+			ww := Width(word)
+			if n > 0 && n+1+ww > w {
+				flush()
+			}
+			if n > 0 {
+				line.WriteByte(' ')
+				n++
+			}
+
+			// Cut the words that can't fit on a line of their own
+			for ww > w && w > 0 {
+				var head strings.Builder
+				hw := 0
+				rest := word
+				for i, r := range word {
+					rw := runeWidth(r)
+					if hw+rw > w-n {
+						rest = word[i:]
+						break
+					}
+					head.WriteRune(r)
+					hw += rw
+				}
+				if hw == 0 {
+					break
+				}
+				line.WriteString(head.String())
+				flush()
+				word, ww = rest, Width(rest)
+			}
+
+			line.WriteString(word)
+			n += ww
+		}
+		flush()
+	}
+
+	return lines
+}
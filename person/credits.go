@@ -0,0 +1,184 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// creditResults holds a single cast or crew entry. Movie and TV credits
+// share the same shape apart from their title and date fields, and the
+// combined endpoint tags every entry with its media type
+type creditResults struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	GenreIds         []int    `json:"genre_ids"`
+	ID               int      `json:"id"`
+	OriginCountry    []string `json:"origin_country,omitempty"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalTitle    string   `json:"original_title,omitempty"`
+	OriginalName     string   `json:"original_name,omitempty"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	ReleaseDate      string   `json:"release_date,omitempty"`
+	FirstAirDate     string   `json:"first_air_date,omitempty"`
+	Title            string   `json:"title,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	Character        string   `json:"character,omitempty"`
+	EpisodeCount     int      `json:"episode_count,omitempty"`
+	Department       string   `json:"department,omitempty"`
+	Job              string   `json:"job,omitempty"`
+	CreditID         string   `json:"credit_id"`
+	MediaType        string   `json:"media_type,omitempty"`
+}
+
+// DisplayTitle returns the movie title or the TV show name
+func (c creditResults) DisplayTitle() string {
+	if c.Title != "" {
+		return c.Title
+	}
+	return c.Name
+}
+
+// Date returns the release date of a movie or the first air date of a TV show
+func (c creditResults) Date() string {
+	if c.ReleaseDate != "" {
+		return c.ReleaseDate
+	}
+	return c.FirstAirDate
+}
+
+type CreditsResponse struct {
+	Cast []creditResults `json:"cast"`
+	Crew []creditResults `json:"crew"`
+	ID   int             `json:"id"`
+}
+
+// GetCredits fetches the movie, tv or combined credits of a person, the
+// titles in language
+func GetCredits(url, creditType, language string) (*CreditsResponse, error) {
+
+	u := fmt.Sprintf("%s/%s_credits?language=%s", url, creditType, language)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *CreditsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	// Movie and TV credits don't carry a media type, fill it in so callers
+	// can treat all three variants alike
+	if creditType != "combined" {
+		for i := range resp.Cast {
+			resp.Cast[i].MediaType = creditType
+		}
+		for i := range resp.Crew {
+			resp.Crew[i].MediaType = creditType
+		}
+	}
+
+	return resp, nil
+}
+
+// Filter keeps the credits matching department and job, ignoring case. An
+// empty value matches anything. Cast entries don't carry a department or a
+// job, they are treated as the "Acting" department and dropped whenever a
+// job is requested
+func (r *CreditsResponse) Filter(department, job string) {
+	if department == "" && job == "" {
+		return
+	}
+
+	cast := r.Cast[:0]
+	for _, c := range r.Cast {
+		if job != "" {
+			continue
+		}
+		if department != "" && !strings.EqualFold(department, "Acting") {
+			continue
+		}
+		cast = append(cast, c)
+	}
+	r.Cast = cast
+
+	crew := r.Crew[:0]
+	for _, c := range r.Crew {
+		if department != "" && !strings.EqualFold(department, c.Department) {
+			continue
+		}
+		if job != "" && !strings.EqualFold(job, c.Job) {
+			continue
+		}
+		crew = append(crew, c)
+	}
+	r.Crew = crew
+}
+
+// Exclude drops the credits for which skip returns true
+func (r *CreditsResponse) Exclude(skip func(mediaType string, id int) bool) {
+	cast := r.Cast[:0]
+	for _, c := range r.Cast {
+		if !skip(c.MediaType, c.ID) {
+			cast = append(cast, c)
+		}
+	}
+	r.Cast = cast
+
+	crew := r.Crew[:0]
+	for _, c := range r.Crew {
+		if !skip(c.MediaType, c.ID) {
+			crew = append(crew, c)
+		}
+	}
+	r.Crew = crew
+}
+
+// Sort orders the credits by "date" (newest first, undated last) or by
+// "popularity" (most popular first)
+func (r *CreditsResponse) Sort(by string) error {
+	var less func(a, b creditResults) bool
+
+	switch by {
+	case "date":
+		less = func(a, b creditResults) bool {
+			da, db := a.Date(), b.Date()
+			if da == "" || db == "" {
+				return db == "" && da != ""
+			}
+			return da > db
+		}
+	case "popularity":
+		less = func(a, b creditResults) bool {
+			return a.Popularity > b.Popularity
+		}
+	default:
+		return ErrInvalidSort
+	}
+
+	sort.SliceStable(r.Cast, func(i, j int) bool { return less(r.Cast[i], r.Cast[j]) })
+	sort.SliceStable(r.Crew, func(i, j int) bool { return less(r.Crew[i], r.Crew[j]) })
+
+	return nil
+}
+
+// ResolveImages replaces the image paths with the URLs returned by url
+func (r *CreditsResponse) ResolveImages(url func(kind, path string) string) {
+	for i := range r.Cast {
+		r.Cast[i].PosterPath = url("poster", r.Cast[i].PosterPath)
+		r.Cast[i].BackdropPath = url("backdrop", r.Cast[i].BackdropPath)
+	}
+	for i := range r.Crew {
+		r.Crew[i].PosterPath = url("poster", r.Crew[i].PosterPath)
+		r.Crew[i].BackdropPath = url("backdrop", r.Crew[i].BackdropPath)
+	}
+}
@@ -0,0 +1,60 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+	ErrInvalidSort     = errors.New("invalid sort field")
+)
+
+var sendRequest = client.SendRequest
+
+type DetailsResponse struct {
+	Adult              bool     `json:"adult"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           string   `json:"birthday"`
+	Deathday           string   `json:"deathday"`
+	Gender             int      `json:"gender"`
+	Homepage           string   `json:"homepage"`
+	ID                 int      `json:"id"`
+	ImdbID             string   `json:"imdb_id"`
+	KnownForDepartment string   `json:"known_for_department"`
+	Name               string   `json:"name"`
+	PlaceOfBirth       string   `json:"place_of_birth"`
+	Popularity         float64  `json:"popularity"`
+	ProfilePath        string   `json:"profile_path"`
+}
+
+// GetDetails fetches the details of the person at url, the biography in
+// language
+func GetDetails(url, language string) (*DetailsResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ResolveImages replaces the profile path with the URL returned by url
+func (r *DetailsResponse) ResolveImages(url func(kind, path string) string) {
+	r.ProfilePath = url("profile", r.ProfilePath)
+}
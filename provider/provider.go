@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type providerResults struct {
+	DisplayPriority int    `json:"display_priority"`
+	LogoPath        string `json:"logo_path"`
+	ProviderID      int    `json:"provider_id"`
+	ProviderName    string `json:"provider_name"`
+}
+
+type regionResults struct {
+	Link     string            `json:"link"`
+	Flatrate []providerResults `json:"flatrate,omitempty"`
+	Rent     []providerResults `json:"rent,omitempty"`
+	Buy      []providerResults `json:"buy,omitempty"`
+	Free     []providerResults `json:"free,omitempty"`
+	Ads      []providerResults `json:"ads,omitempty"`
+}
+
+type Response struct {
+	ID      int                      `json:"id"`
+	Results map[string]regionResults `json:"results"`
+}
+
+// Get fetches the watch providers of the movie or TV show at url for every
+// region
+func Get(url string) (*Response, error) {
+
+	u := fmt.Sprintf("%s/watch/providers", url)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Filter keeps the providers of the given region whose name matches one of
+// services, compared case insensitively. Other regions are dropped. An
+// empty services list keeps every provider of the region
+func (r *Response) Filter(region string, services []string) {
+	res, ok := r.Results[region]
+	r.Results = map[string]regionResults{}
+	if !ok {
+		return
+	}
+
+	if len(services) > 0 {
+		keep := func(providers []providerResults) []providerResults {
+			var kept []providerResults
+			for _, p := range providers {
+				for _, s := range services {
+					if strings.EqualFold(strings.TrimSpace(s), p.ProviderName) {
+						kept = append(kept, p)
+						break
+					}
+				}
+			}
+			return kept
+		}
+
+		res.Flatrate = keep(res.Flatrate)
+		res.Rent = keep(res.Rent)
+		res.Buy = keep(res.Buy)
+		res.Free = keep(res.Free)
+		res.Ads = keep(res.Ads)
+	}
+
+	r.Results[region] = res
+}
+
+// Names returns the provider names of an offer type: flatrate, rent, buy,
+// free or ads
+func (r *Response) Names(region, offer string) []string {
+	res := r.Results[region]
+
+	var providers []providerResults
+	switch offer {
+	case "flatrate":
+		providers = res.Flatrate
+	case "rent":
+		providers = res.Rent
+	case "buy":
+		providers = res.Buy
+	case "free":
+		providers = res.Free
+	case "ads":
+		providers = res.Ads
+	}
+
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, p.ProviderName)
+	}
+	return names
+}
@@ -0,0 +1,61 @@
+package review
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type authorDetails struct {
+	Name       string   `json:"name"`
+	Username   string   `json:"username"`
+	AvatarPath string   `json:"avatar_path"`
+	Rating     *float64 `json:"rating"`
+}
+
+type reviewResults struct {
+	Author        string        `json:"author"`
+	AuthorDetails authorDetails `json:"author_details"`
+	Content       string        `json:"content"`
+	CreatedAt     string        `json:"created_at"`
+	ID            string        `json:"id"`
+	UpdatedAt     string        `json:"updated_at"`
+	URL           string        `json:"url"`
+}
+
+type Response struct {
+	ID           int             `json:"id"`
+	Page         int             `json:"page"`
+	Results      []reviewResults `json:"results"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+}
+
+// Get fetches a page of the reviews of the movie or TV show at url written
+// in language
+func Get(url string, page int, language string) (*Response, error) {
+
+	u := fmt.Sprintf("%s/reviews?language=%s&page=%d", url, language, page)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
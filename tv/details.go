@@ -0,0 +1,83 @@
+package tv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"example.com/dummyheaad/tmdbCLI/client"
+)
+
+var (
+	ErrNotFound        = client.ErrNotFound
+	ErrInvalidResponse = client.ErrInvalidResponse
+)
+
+var sendRequest = client.SendRequest
+
+type genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type network struct {
+	ID            int    `json:"id"`
+	LogoPath      string `json:"logo_path"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
+}
+
+type DetailsResponse struct {
+	Adult            bool      `json:"adult"`
+	BackdropPath     string    `json:"backdrop_path"`
+	FirstAirDate     string    `json:"first_air_date"`
+	Genres           []genre   `json:"genres"`
+	Homepage         string    `json:"homepage"`
+	ID               int       `json:"id"`
+	InProduction     bool      `json:"in_production"`
+	LastAirDate      string    `json:"last_air_date"`
+	Name             string    `json:"name"`
+	Networks         []network `json:"networks"`
+	NumberOfEpisodes int       `json:"number_of_episodes"`
+	NumberOfSeasons  int       `json:"number_of_seasons"`
+	OriginCountry    []string  `json:"origin_country"`
+	OriginalLanguage string    `json:"original_language"`
+	OriginalName     string    `json:"original_name"`
+	Overview         string    `json:"overview"`
+	Popularity       float64   `json:"popularity"`
+	PosterPath       string    `json:"poster_path"`
+	Status           string    `json:"status"`
+	Tagline          string    `json:"tagline"`
+	Type             string    `json:"type"`
+	VoteAverage      float64   `json:"vote_average"`
+	VoteCount        int       `json:"vote_count"`
+}
+
+// DisplayName returns the name in the requested language, or the original
+// name when TMDB has no translation
+func (r *DetailsResponse) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.OriginalName
+}
+
+// GetDetails fetches the details of the TV show at url, the title and
+// overview in language
+func GetDetails(url, language string) (*DetailsResponse, error) {
+
+	u := fmt.Sprintf("%s?language=%s", url, language)
+
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *DetailsResponse
+	if err := json.NewDecoder(bytes.NewReader(respByte)).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package tv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type KeywordsResponse struct {
+	ID      int       `json:"id"`
+	Results []keyword `json:"results"`
+}
+
+type altTitle struct {
+	ISO_3166_1 string `json:"iso_3166_1"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+}
+
+type AltTitlesResponse struct {
+	ID      int        `json:"id"`
+	Results []altTitle `json:"results"`
+}
+
+type translationData struct {
+	Homepage string `json:"homepage"`
+	Name     string `json:"name"`
+	Overview string `json:"overview"`
+	Tagline  string `json:"tagline"`
+}
+
+type translation struct {
+	ISO_3166_1  string          `json:"iso_3166_1"`
+	ISO_639_1   string          `json:"iso_639_1"`
+	Name        string          `json:"name"`
+	EnglishName string          `json:"english_name"`
+	Data        translationData `json:"data"`
+}
+
+// Language returns the language tag of the translation, e.g. en-US
+func (t translation) Language() string {
+	return fmt.Sprintf("%s-%s", t.ISO_639_1, t.ISO_3166_1)
+}
+
+type TranslationsResponse struct {
+	ID           int           `json:"id"`
+	Translations []translation `json:"translations"`
+}
+
+func get(u string, resp any) error {
+	respByte, err := sendRequest(u, http.MethodGet, "", http.StatusOK, nil)
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(bytes.NewReader(respByte)).Decode(resp)
+}
+
+// GetKeywords fetches the keywords of the TV show at url
+func GetKeywords(url string) (*KeywordsResponse, error) {
+	var resp *KeywordsResponse
+	if err := get(fmt.Sprintf("%s/keywords", url), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAltTitles fetches the alternative titles of the TV show at url
+func GetAltTitles(url string) (*AltTitlesResponse, error) {
+	var resp *AltTitlesResponse
+	if err := get(fmt.Sprintf("%s/alternative_titles", url), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetTranslations fetches the translations of the TV show at url
+func GetTranslations(url string) (*TranslationsResponse, error) {
+	var resp *TranslationsResponse
+	if err := get(fmt.Sprintf("%s/translations", url), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}